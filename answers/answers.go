@@ -0,0 +1,133 @@
+package answers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+)
+
+// DefaultPath is where the known answers are kept
+const DefaultPath = "answers.json"
+
+// Entry is a known answer for one part of a day, for one input
+type Entry struct {
+	Day       int    `json:"day"`
+	Part      int    `json:"part"`
+	InputHash string `json:"input_hash"`
+	Answer    string `json:"answer"`
+}
+
+type key struct {
+	day       int
+	part      int
+	inputHash string
+}
+
+// Store holds the known answers, keyed by day, part and input hash
+type Store struct {
+	path    string
+	entries map[key]string
+}
+
+// HashInput identifies a puzzle input, so answers for different inputs can be kept side by side
+func HashInput(input string) string {
+	sum := sha256.Sum256([]byte(input))
+	return hex.EncodeToString(sum[:])
+}
+
+// HashReader is HashInput for an input read from r
+func HashReader(r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Load reads the answers file at path, a missing file gives an empty store
+func Load(path string) (*Store, error) {
+	store := &Store{path: path, entries: make(map[key]string)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	entries := []Entry{}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	for _, e := range entries {
+		store.entries[key{day: e.Day, part: e.Part, inputHash: e.InputHash}] = e.Answer
+	}
+	return store, nil
+}
+
+// Lookup finds the known answer for a part and input
+func (s *Store) Lookup(day, part int, inputHash string) (string, bool) {
+	answer, ok := s.entries[key{day: day, part: part, inputHash: inputHash}]
+	return answer, ok
+}
+
+// Set records the answer for a part and input
+func (s *Store) Set(day, part int, inputHash, answer string) {
+	s.entries[key{day: day, part: part, inputHash: inputHash}] = answer
+}
+
+// Entries returns every known answer, ordered by day, part and input hash
+func (s *Store) Entries() []Entry {
+	entries := make([]Entry, 0, len(s.entries))
+	for k, answer := range s.entries {
+		entries = append(entries, Entry{Day: k.day, Part: k.part, InputHash: k.inputHash, Answer: answer})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Day != entries[j].Day {
+			return entries[i].Day < entries[j].Day
+		}
+		if entries[i].Part != entries[j].Part {
+			return entries[i].Part < entries[j].Part
+		}
+		return entries[i].InputHash < entries[j].InputHash
+	})
+	return entries
+}
+
+// Save writes the store back to the file it was loaded from
+// entries are sorted so the file diffs cleanly
+func (s *Store) Save() error {
+	data, err := json.MarshalIndent(s.Entries(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, append(data, '\n'), 0o644)
+}
+
+// Outcome of checking an answer against the store
+type Outcome string
+
+const (
+	Pass    Outcome = "pass"
+	Fail    Outcome = "fail"
+	Missing Outcome = "missing"
+)
+
+// Check compares an answer against the known answer for a part and input
+// it also returns the known answer, empty when missing
+func (s *Store) Check(day, part int, inputHash, answer string) (Outcome, string) {
+	known, ok := s.Lookup(day, part, inputHash)
+	switch {
+	case !ok:
+		return Missing, ""
+	case known == answer:
+		return Pass, known
+	default:
+		return Fail, known
+	}
+}
@@ -0,0 +1,90 @@
+package answers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestHashInput(t *testing.T) {
+	if HashInput("a") == HashInput("b") {
+		t.Errorf("HashInput() gave the same hash for different inputs")
+	}
+	if HashInput("abc") != HashInput("abc") {
+		t.Errorf("HashInput() is not stable")
+	}
+	if hash, err := HashReader(strings.NewReader("abc")); err != nil || hash != HashInput("abc") {
+		t.Errorf("HashReader(abc) = %s, %v, expected %s", hash, err, HashInput("abc"))
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	store, err := Load(filepath.Join(t.TempDir(), "answers.json"))
+	if err != nil {
+		t.Fatalf("Load() unexpected error %v", err)
+	}
+	if len(store.Entries()) != 0 {
+		t.Errorf("Load() = %d entries, expected 0", len(store.Entries()))
+	}
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+	store, _ := Load(path)
+	store.Set(2, 1, "bbb", "42")
+	store.Set(1, 2, "aaa", "7")
+	store.Set(1, 1, "aaa", "3")
+	if err := store.Save(); err != nil {
+		t.Fatalf("Save() unexpected error %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() unexpected error %v", err)
+	}
+	entries := loaded.Entries()
+	expected := []Entry{
+		{Day: 1, Part: 1, InputHash: "aaa", Answer: "3"},
+		{Day: 1, Part: 2, InputHash: "aaa", Answer: "7"},
+		{Day: 2, Part: 1, InputHash: "bbb", Answer: "42"},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("Entries() = %v, expected %v", entries, expected)
+	}
+	for i := range expected {
+		if entries[i] != expected[i] {
+			t.Errorf("Entries()[%d] = %v, expected %v", i, entries[i], expected[i])
+		}
+	}
+}
+
+func TestLoadInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+	os.WriteFile(path, []byte("{not json"), 0o644)
+	if _, err := Load(path); err == nil {
+		t.Errorf("Load() expected error for invalid JSON, got nil")
+	}
+}
+
+func TestCheck(t *testing.T) {
+	store, _ := Load(filepath.Join(t.TempDir(), "answers.json"))
+	store.Set(1, 1, "aaa", "3")
+	tests := []struct {
+		day, part int
+		hash      string
+		answer    string
+		expected  Outcome
+	}{
+		{1, 1, "aaa", "3", Pass},
+		{1, 1, "aaa", "4", Fail},
+		{1, 1, "bbb", "3", Missing},
+		{1, 2, "aaa", "3", Missing},
+	}
+	for _, test := range tests {
+		outcome, _ := store.Check(test.day, test.part, test.hash, test.answer)
+		if outcome != test.expected {
+			t.Errorf("Check(%d, %d, %s, %s) = %s, expected %s", test.day, test.part, test.hash, test.answer, outcome, test.expected)
+		}
+	}
+}
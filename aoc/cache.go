@@ -0,0 +1,37 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"aoc2025/vault"
+)
+
+// FetchToFile downloads a day's input to path, unless path or its encrypted
+// copy already exists
+// it reports whether a download happened, so an input is never fetched twice
+func (c *Client) FetchToFile(ctx context.Context, year, day int, path string) (bool, error) {
+	for _, cached := range []string{path, path + vault.Ext} {
+		if _, err := os.Stat(cached); err == nil {
+			return false, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return false, err
+		}
+	}
+	input, err := c.FetchInput(ctx, year, day)
+	if err != nil {
+		return false, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return false, err
+	}
+	// write to a temporary file first so an interrupted write is not cached
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(input), 0o600); err != nil {
+		return false, err
+	}
+	return true, os.Rename(tmp, path)
+}
@@ -0,0 +1,222 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the Advent of Code site
+	DefaultBaseURL = "https://adventofcode.com"
+	// DefaultUserAgent tells the site who is automating requests, as its etiquette asks
+	DefaultUserAgent = "github.com/simbachu/aoc2025 by simbachu"
+	// DefaultMinInterval is the least time between two requests to the site
+	DefaultMinInterval = 3 * time.Second
+
+	// SessionEnv is the environment variable holding the session cookie
+	SessionEnv = "AOC_SESSION"
+)
+
+var (
+	// ErrNoSession is returned when no session cookie could be found
+	ErrNoSession = errors.New("no session cookie, set " + SessionEnv + " or write it to the session file")
+	// ErrLocked is returned when asking for a puzzle that has not unlocked yet
+	ErrLocked = errors.New("puzzle not unlocked yet")
+	// ErrUnauthorized is returned when the site does not accept the session cookie
+	ErrUnauthorized = errors.New("session cookie rejected, log in again and update it")
+)
+
+// eastern is the time zone puzzles unlock in, at midnight
+var eastern = time.FixedZone("EST", -5*60*60)
+
+// UnlockTime is when a puzzle becomes available
+func UnlockTime(year, day int) time.Time {
+	return time.Date(year, time.December, day, 0, 0, 0, 0, eastern)
+}
+
+// SessionFile is where the session cookie is kept when it is not in the environment
+func SessionFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "aoc2025", "session"), nil
+}
+
+// StateFile is where the time of the last request is kept, so the throttle
+// holds across runs of the command
+func StateFile() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "aoc2025", "last-request"), nil
+}
+
+// LoadSession reads the session cookie from SessionEnv, or from SessionFile
+func LoadSession() (string, error) {
+	if session := strings.TrimSpace(os.Getenv(SessionEnv)); session != "" {
+		return session, nil
+	}
+	path, err := SessionFile()
+	if err != nil {
+		return "", ErrNoSession
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", ErrNoSession
+	}
+	session := strings.TrimSpace(string(data))
+	if session == "" {
+		return "", ErrNoSession
+	}
+	return session, nil
+}
+
+// Client talks to the Advent of Code site
+// requests are throttled to one per MinInterval, across runs when StateFile is set
+type Client struct {
+	BaseURL     string
+	Session     string
+	UserAgent   string
+	MinInterval time.Duration
+	HTTPClient  *http.Client
+	Now         func() time.Time // clock used for unlock checks, time.Now when nil
+	StateFile   string           // keeps the time of the last request, none when empty
+
+	mu          sync.Mutex
+	lastRequest time.Time
+}
+
+// NewClient makes a client for the real site with the given session cookie
+func NewClient(session string) *Client {
+	stateFile, _ := StateFile()
+	return &Client{
+		BaseURL:     DefaultBaseURL,
+		Session:     session,
+		UserAgent:   DefaultUserAgent,
+		MinInterval: DefaultMinInterval,
+		HTTPClient:  &http.Client{Timeout: 30 * time.Second},
+		StateFile:   stateFile,
+	}
+}
+
+func (c *Client) now() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return time.Now()
+}
+
+// Unlocked reports whether a puzzle is available yet
+func (c *Client) Unlocked(year, day int) bool {
+	return !c.now().Before(UnlockTime(year, day))
+}
+
+// FetchInput downloads the puzzle input for a day
+func (c *Client) FetchInput(ctx context.Context, year, day int) (string, error) {
+	if !c.Unlocked(year, day) {
+		return "", fmt.Errorf("%d day %d: %w, unlocks at %v", year, day, ErrLocked, UnlockTime(year, day))
+	}
+	resp, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/%d/day/%d/input", year, day), nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return string(body), nil
+	case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized:
+		return "", ErrUnauthorized
+	case resp.StatusCode == http.StatusNotFound:
+		return "", fmt.Errorf("%d day %d: %w", year, day, ErrLocked)
+	default:
+		return "", fmt.Errorf("fetching %d day %d: %s", year, day, resp.Status)
+	}
+}
+
+// do sends a request to the site, waiting first if the last request was too recent
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+	if err := c.throttle(ctx); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(c.BaseURL, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	req.Header.Set("User-Agent", c.UserAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return httpClient.Do(req)
+}
+
+func (c *Client) throttle(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.lastRequest.IsZero() {
+		c.lastRequest = c.loadLastRequest()
+	}
+	wait := c.MinInterval - time.Since(c.lastRequest)
+	if !c.lastRequest.IsZero() && wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	c.lastRequest = time.Now()
+	c.saveLastRequest()
+	return nil
+}
+
+// loadLastRequest reads the time of the last request of an earlier run,
+// the zero time when there is none
+func (c *Client) loadLastRequest() time.Time {
+	if c.StateFile == "" {
+		return time.Time{}
+	}
+	data, err := os.ReadFile(c.StateFile)
+	if err != nil {
+		return time.Time{}
+	}
+	last, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(string(data)))
+	if err != nil {
+		return time.Time{}
+	}
+	return last
+}
+
+// saveLastRequest keeps the time of the last request for later runs
+// it is best effort, a throttle that does not persist only ever waits less
+func (c *Client) saveLastRequest() {
+	if c.StateFile == "" {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(c.StateFile), 0o755); err != nil {
+		return
+	}
+	os.WriteFile(c.StateFile, []byte(c.lastRequest.Format(time.RFC3339Nano)+"\n"), 0o644)
+}
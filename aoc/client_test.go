@@ -0,0 +1,200 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// kAfterUnlock is a moment when every 2025 puzzle is available
+var kAfterUnlock = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestClient(url string) *Client {
+	client := NewClient("secret")
+	client.BaseURL = url
+	client.MinInterval = 0
+	client.StateFile = ""
+	client.Now = func() time.Time { return kAfterUnlock }
+	return client
+}
+
+func TestUnlockTime(t *testing.T) {
+	tests := []struct {
+		now      time.Time
+		day      int
+		expected bool
+	}{
+		{time.Date(2025, time.December, 3, 4, 59, 59, 0, time.UTC), 3, false},
+		{time.Date(2025, time.December, 3, 5, 0, 0, 0, time.UTC), 3, true},
+		{time.Date(2025, time.December, 3, 5, 0, 0, 0, time.UTC), 4, false},
+		{time.Date(2025, time.November, 30, 0, 0, 0, 0, time.UTC), 1, false},
+	}
+	for _, test := range tests {
+		client := &Client{Now: func() time.Time { return test.now }}
+		if result := client.Unlocked(2025, test.day); result != test.expected {
+			t.Errorf("Unlocked(2025, %d) at %v = %v, expected %v", test.day, test.now, result, test.expected)
+		}
+	}
+}
+
+func TestFetchInput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2025/day/3/input" {
+			http.NotFound(w, r)
+			return
+		}
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		if r.UserAgent() != DefaultUserAgent {
+			http.Error(w, "missing user agent", http.StatusForbidden)
+			return
+		}
+		w.Write([]byte("987654321111111\n"))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	input, err := client.FetchInput(context.Background(), 2025, 3)
+	if err != nil || input != "987654321111111\n" {
+		t.Errorf("FetchInput(2025, 3) = %q, %v, expected the input", input, err)
+	}
+	if _, err := client.FetchInput(context.Background(), 2025, 4); !errors.Is(err, ErrLocked) {
+		t.Errorf("FetchInput(2025, 4) error = %v, expected ErrLocked", err)
+	}
+
+	client.Session = "stale"
+	if _, err := client.FetchInput(context.Background(), 2025, 3); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("FetchInput with a stale session error = %v, expected ErrUnauthorized", err)
+	}
+	client.Session = ""
+	if _, err := client.FetchInput(context.Background(), 2025, 3); !errors.Is(err, ErrNoSession) {
+		t.Errorf("FetchInput without a session error = %v, expected ErrNoSession", err)
+	}
+}
+
+func TestFetchInputRefusesLockedDays(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.Now = func() time.Time { return time.Date(2025, time.December, 5, 12, 0, 0, 0, time.UTC) }
+	if _, err := client.FetchInput(context.Background(), 2025, 6); !errors.Is(err, ErrLocked) {
+		t.Errorf("FetchInput(2025, 6) error = %v, expected ErrLocked", err)
+	}
+	if requests.Load() != 0 {
+		t.Errorf("FetchInput of a locked day sent %d requests, expected none", requests.Load())
+	}
+}
+
+func TestFetchToFile(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte("L68\nR48\n"))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	path := filepath.Join(t.TempDir(), "day1", "data.txt")
+	for i, expected := range []bool{true, false} {
+		fetched, err := client.FetchToFile(context.Background(), 2025, 1, path)
+		if err != nil || fetched != expected {
+			t.Errorf("FetchToFile call %d = %v, %v, expected %v", i+1, fetched, err, expected)
+		}
+	}
+	if requests.Load() != 1 {
+		t.Errorf("FetchToFile twice sent %d requests, expected 1", requests.Load())
+	}
+	if data, err := os.ReadFile(path); err != nil || string(data) != "L68\nR48\n" {
+		t.Errorf("FetchToFile wrote %q, %v, expected the input", data, err)
+	}
+
+	// an input only kept encrypted is cached as well
+	encrypted := filepath.Join(t.TempDir(), "day2", "data.txt")
+	os.MkdirAll(filepath.Dir(encrypted), 0o755)
+	os.WriteFile(encrypted+".enc", []byte("sealed"), 0o644)
+	if fetched, err := client.FetchToFile(context.Background(), 2025, 2, encrypted); err != nil || fetched {
+		t.Errorf("FetchToFile with an encrypted copy = %v, %v, expected false, nil", fetched, err)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("FetchToFile with an encrypted copy sent a request")
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.MinInterval = 50 * time.Millisecond
+	start := time.Now()
+	for range 3 {
+		if _, err := client.FetchInput(context.Background(), 2025, 1); err != nil {
+			t.Fatalf("FetchInput error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("three throttled requests took %v, expected at least 100ms", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.FetchInput(ctx, 2025, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("FetchInput with a cancelled context error = %v, expected context.Canceled", err)
+	}
+}
+
+func TestThrottleAcrossRuns(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	state := filepath.Join(t.TempDir(), "aoc2025", "last-request")
+	start := time.Now()
+	// every run of a command makes a new client
+	for range 2 {
+		client := newTestClient(server.URL)
+		client.MinInterval = 80 * time.Millisecond
+		client.StateFile = state
+		if _, err := client.FetchInput(context.Background(), 2025, 1); err != nil {
+			t.Fatalf("FetchInput error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("two runs took %v, expected the second to wait at least 80ms", elapsed)
+	}
+}
+
+func TestLoadSession(t *testing.T) {
+	t.Setenv(SessionEnv, " from-env\n")
+	if session, err := LoadSession(); err != nil || session != "from-env" {
+		t.Errorf("LoadSession() = %q, %v, expected from-env", session, err)
+	}
+
+	config := t.TempDir()
+	t.Setenv(SessionEnv, "")
+	t.Setenv("XDG_CONFIG_HOME", config)
+	t.Setenv("HOME", config)
+	if _, err := LoadSession(); !errors.Is(err, ErrNoSession) {
+		t.Errorf("LoadSession() without a session error = %v, expected ErrNoSession", err)
+	}
+	path, err := SessionFile()
+	if err != nil {
+		t.Fatalf("SessionFile() error = %v", err)
+	}
+	os.MkdirAll(filepath.Dir(path), 0o755)
+	os.WriteFile(path, []byte("from-file\n"), 0o600)
+	if session, err := LoadSession(); err != nil || session != "from-file" {
+		t.Errorf("LoadSession() = %q, %v, expected from-file", session, err)
+	}
+}
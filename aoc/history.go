@@ -0,0 +1,114 @@
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"time"
+)
+
+// DefaultHistoryPath is where past guesses are kept
+const DefaultHistoryPath = "guesses.json"
+
+var (
+	// ErrAlreadyRejected is returned when an answer was already submitted and judged wrong
+	ErrAlreadyRejected = errors.New("answer already rejected")
+	// ErrOutOfBounds is returned when an answer is outside the bounds past guesses established
+	ErrOutOfBounds = errors.New("answer outside known bounds")
+	// ErrAlreadyCorrect is returned when the part has already been solved
+	ErrAlreadyCorrect = errors.New("part already solved")
+)
+
+// Guess is one answer submitted for a part of a day
+type Guess struct {
+	Day     int       `json:"day"`
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	Time    time.Time `json:"time"`
+}
+
+// History holds every guess made, in the order they were made
+type History struct {
+	path    string
+	guesses []Guess
+}
+
+// LoadHistory reads the history file at path, a missing file gives an empty history
+func LoadHistory(path string) (*History, error) {
+	history := &History{path: path}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return history, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &history.guesses); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return history, nil
+}
+
+// Guesses returns the guesses made for a part of a day, oldest first
+func (h *History) Guesses(day, part int) []Guess {
+	guesses := []Guess{}
+	for _, g := range h.guesses {
+		if g.Day == day && g.Part == part {
+			guesses = append(guesses, g)
+		}
+	}
+	return guesses
+}
+
+// Add records a guess
+func (h *History) Add(guess Guess) {
+	h.guesses = append(h.guesses, guess)
+}
+
+// Save writes the history back to the file it was loaded from
+func (h *History) Save() error {
+	data, err := json.MarshalIndent(h.guesses, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(h.path, append(data, '\n'), 0o644)
+}
+
+// Check reports why an answer should not be submitted, nil if it is worth a try
+// numeric answers are compared against the tightest too high and too low guesses
+func (h *History) Check(day, part int, answer string) error {
+	var low, high *big.Int
+	value, numeric := new(big.Int).SetString(answer, 10)
+	for _, g := range h.Guesses(day, part) {
+		if g.Verdict == Correct {
+			return fmt.Errorf("%w with %s", ErrAlreadyCorrect, g.Answer)
+		}
+		if g.Answer == answer && g.Verdict.Judged() {
+			return fmt.Errorf("%s: %w as %s", answer, ErrAlreadyRejected, g.Verdict)
+		}
+		guessed, ok := new(big.Int).SetString(g.Answer, 10)
+		if !ok {
+			continue
+		}
+		switch {
+		case g.Verdict == TooHigh && (high == nil || guessed.Cmp(high) < 0):
+			high = guessed
+		case g.Verdict == TooLow && (low == nil || guessed.Cmp(low) > 0):
+			low = guessed
+		}
+	}
+	if !numeric {
+		return nil
+	}
+	if high != nil && value.Cmp(high) >= 0 {
+		return fmt.Errorf("%s: %w, %s was too high", answer, ErrOutOfBounds, high)
+	}
+	if low != nil && value.Cmp(low) <= 0 {
+		return fmt.Errorf("%s: %w, %s was too low", answer, ErrOutOfBounds, low)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package aoc
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict is how the site judged a submitted answer
+type Verdict string
+
+const (
+	Correct       Verdict = "correct"
+	Wrong         Verdict = "wrong"
+	TooHigh       Verdict = "too high"
+	TooLow        Verdict = "too low"
+	RateLimited   Verdict = "rate limited"
+	AlreadySolved Verdict = "already solved"
+	Unknown       Verdict = "unknown"
+)
+
+// Judged reports whether the verdict says something about the answer itself
+func (v Verdict) Judged() bool {
+	return v == Correct || v == Wrong || v == TooHigh || v == TooLow
+}
+
+// Response is the parsed reply to a submission
+type Response struct {
+	Verdict Verdict
+	Wait    time.Duration // how long to wait before the next guess, when known
+	Message string        // the text of the reply, without markup
+}
+
+var (
+	articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagPattern     = regexp.MustCompile(`<[^>]+>`)
+	spacePattern   = regexp.MustCompile(`\s+`)
+	waitPattern    = regexp.MustCompile(`(?:(\d+)m )?(\d+)s left to wait|wait (one|\d+) minutes?`)
+)
+
+// ParseResponse reads the verdict out of the page returned for a submission
+func ParseResponse(page string) Response {
+	text := page
+	if match := articlePattern.FindStringSubmatch(page); match != nil {
+		text = match[1]
+	}
+	text = html.UnescapeString(tagPattern.ReplaceAllString(text, ""))
+	text = strings.TrimSpace(spacePattern.ReplaceAllString(text, " "))
+
+	response := Response{Verdict: Unknown, Message: text}
+	switch {
+	case strings.Contains(text, "That's the right answer"):
+		response.Verdict = Correct
+	case strings.Contains(text, "You gave an answer too recently"):
+		response.Verdict = RateLimited
+	case strings.Contains(text, "You don't seem to be solving the right level"):
+		response.Verdict = AlreadySolved
+	case strings.Contains(text, "your answer is too high"):
+		response.Verdict = TooHigh
+	case strings.Contains(text, "your answer is too low"):
+		response.Verdict = TooLow
+	case strings.Contains(text, "That's not the right answer"):
+		response.Verdict = Wrong
+	}
+	response.Wait = parseWait(text)
+	return response
+}
+
+func parseWait(text string) time.Duration {
+	match := waitPattern.FindStringSubmatch(text)
+	if match == nil {
+		return 0
+	}
+	if match[2] != "" {
+		minutes, _ := strconv.Atoi(match[1])
+		seconds, _ := strconv.Atoi(match[2])
+		return time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	}
+	if match[3] == "one" {
+		return time.Minute
+	}
+	minutes, _ := strconv.Atoi(match[3])
+	return time.Duration(minutes) * time.Minute
+}
+
+// Submit posts an answer for one part of a day and parses the reply
+func (c *Client) Submit(ctx context.Context, year, day, part int, answer string) (Response, error) {
+	if !c.Unlocked(year, day) {
+		return Response{}, fmt.Errorf("%d day %d: %w, unlocks at %v", year, day, ErrLocked, UnlockTime(year, day))
+	}
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	resp, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Response{}, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Response{}, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized:
+		return Response{}, ErrUnauthorized
+	case resp.StatusCode != http.StatusOK:
+		return Response{}, fmt.Errorf("submitting %d day %d part %d: %s", year, day, part, resp.Status)
+	}
+	return ParseResponse(string(body)), nil
+}
@@ -0,0 +1,131 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func page(message string) string {
+	return `<html><body><main><article><p>` + message + `</p></article></main></body></html>`
+}
+
+func TestParseResponse(t *testing.T) {
+	tests := []struct {
+		page     string
+		expected Verdict
+		wait     time.Duration
+	}{
+		{page(`That's the right answer!  You are <span class="day-success">one gold star</span> closer.`), Correct, 0},
+		{page(`That's not the right answer.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again.`), Wrong, time.Minute},
+		{page(`That's not the right answer; your answer is too high.  Please wait one minute before trying again.`), TooHigh, time.Minute},
+		{page(`That's not the right answer; your answer is too low.  Please wait 5 minutes before trying again.`), TooLow, 5 * time.Minute},
+		{page(`You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 34s left to wait.`), RateLimited, 34 * time.Second},
+		{page(`You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 2m 5s left to wait.`), RateLimited, 2*time.Minute + 5*time.Second},
+		{page(`You don't seem to be solving the right level.  Did you already complete it?`), AlreadySolved, 0},
+		{page(`Something else entirely`), Unknown, 0},
+	}
+	for _, test := range tests {
+		result := ParseResponse(test.page)
+		if result.Verdict != test.expected || result.Wait != test.wait {
+			t.Errorf("ParseResponse(%q) = %v, %v, expected %v, %v", test.page, result.Verdict, result.Wait, test.expected, test.wait)
+		}
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2025/day/1/answer" {
+			http.NotFound(w, r)
+			return
+		}
+		switch {
+		case r.FormValue("level") != "2":
+			w.Write([]byte(page("You don't seem to be solving the right level.")))
+		case r.FormValue("answer") == "6":
+			w.Write([]byte(page("That's the right answer!")))
+		default:
+			w.Write([]byte(page("That's not the right answer; your answer is too high.")))
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	tests := []struct {
+		part     int
+		answer   string
+		expected Verdict
+	}{
+		{2, "6", Correct},
+		{2, "7", TooHigh},
+		{1, "3", AlreadySolved},
+	}
+	for _, test := range tests {
+		response, err := client.Submit(context.Background(), 2025, 1, test.part, test.answer)
+		if err != nil || response.Verdict != test.expected {
+			t.Errorf("Submit(2025, 1, %d, %q) = %v, %v, expected %v", test.part, test.answer, response.Verdict, err, test.expected)
+		}
+	}
+}
+
+func TestHistoryCheck(t *testing.T) {
+	history, err := LoadHistory(filepath.Join(t.TempDir(), "guesses.json"))
+	if err != nil {
+		t.Fatalf("LoadHistory() unexpected error %v", err)
+	}
+	history.Add(Guess{Day: 1, Part: 1, Answer: "100", Verdict: TooHigh})
+	history.Add(Guess{Day: 1, Part: 1, Answer: "150", Verdict: TooHigh})
+	history.Add(Guess{Day: 1, Part: 1, Answer: "20", Verdict: TooLow})
+	history.Add(Guess{Day: 1, Part: 1, Answer: "50", Verdict: Wrong})
+	history.Add(Guess{Day: 1, Part: 2, Answer: "7", Verdict: Correct})
+	history.Add(Guess{Day: 2, Part: 1, Answer: "abc", Verdict: Wrong})
+	history.Add(Guess{Day: 3, Part: 1, Answer: "9", Verdict: RateLimited})
+	history.Add(Guess{Day: 3, Part: 1, Answer: "10", Verdict: Unknown})
+
+	tests := []struct {
+		day      int
+		part     int
+		answer   string
+		expected error
+	}{
+		{1, 1, "60", nil},
+		{1, 1, "50", ErrAlreadyRejected},
+		{1, 1, "100", ErrAlreadyRejected},
+		{1, 1, "120", ErrOutOfBounds},
+		{1, 1, "99", nil},
+		{1, 1, "20", ErrAlreadyRejected},
+		{1, 1, "5", ErrOutOfBounds},
+		{1, 2, "8", ErrAlreadyCorrect},
+		{2, 1, "abc", ErrAlreadyRejected},
+		{2, 1, "abd", nil},
+		{3, 1, "1", nil},
+		{3, 1, "9", nil},
+		{3, 1, "10", nil},
+	}
+	for _, test := range tests {
+		if err := history.Check(test.day, test.part, test.answer); !errors.Is(err, test.expected) {
+			t.Errorf("Check(%d, %d, %q) = %v, expected %v", test.day, test.part, test.answer, err, test.expected)
+		}
+	}
+}
+
+func TestHistorySaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "guesses.json")
+	history, _ := LoadHistory(path)
+	history.Add(Guess{Day: 4, Part: 1, Answer: "13", Verdict: TooLow, Time: time.Date(2025, time.December, 4, 5, 1, 0, 0, time.UTC)})
+	if err := history.Save(); err != nil {
+		t.Fatalf("Save() unexpected error %v", err)
+	}
+	loaded, err := LoadHistory(path)
+	if err != nil {
+		t.Fatalf("LoadHistory() unexpected error %v", err)
+	}
+	guesses := loaded.Guesses(4, 1)
+	if len(guesses) != 1 || guesses[0].Answer != "13" || guesses[0].Verdict != TooLow {
+		t.Errorf("LoadHistory() after Save() = %v, expected the saved guess", guesses)
+	}
+}
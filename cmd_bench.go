@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"aoc2025/runner"
+	"aoc2025/solver"
+)
+
+func benchCommand(args []string) int {
+	fs := newFlagSet("bench", "[flags] [DAYS]\n       aoc2025 bench -compare OLD.json NEW.json", "Run the selected days several times and report the time and memory each part takes.\nWith -compare, report the speedups and regressions between two runs saved with -export.")
+	partsFlag := fs.String("parts", "", "parts to run, 1, 2 or 1,2 (default both)")
+	repeat := fs.Int("repeat", 10, "number of runs per part")
+	exportPath := fs.String("export", "", "also write the results as JSON to `path`")
+	format := fs.String("format", runner.FormatText, "output `format`: text, json, csv or markdown")
+	timeout := fs.Duration("timeout", 0, "cancel a run after `duration`, such as 30s (default no limit)")
+	profile := profileFlags(fs)
+	compare := fs.Bool("compare", false, "compare two exported runs instead of running days")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	if *compare {
+		if fs.NArg() != 2 {
+			fs.Usage()
+			return 2
+		}
+		return compareRuns(fs.Arg(0), fs.Arg(1))
+	}
+	if err := runner.CheckFormat(*format); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 2
+	}
+	if *repeat < 1 {
+		fmt.Fprintf(os.Stderr, "-repeat must be at least 1\n")
+		return 2
+	}
+
+	days, err := selectDays(fs.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	parts, err := runner.ParseParts(*partsFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 2
+	}
+
+	// days run one at a time so allocation counts are not mixed up
+	opts := runner.Options{Parts: parts, Parallel: 1, Repeat: *repeat, Timeout: *timeout, Profile: *profile}
+	exitCode := 0
+	printBench := func(r runner.DayResult) {
+		if r.InputErr != nil {
+			fmt.Fprintf(os.Stderr, "Error reading input for day %d: %v\n", r.Day, r.InputErr)
+			exitCode = 1
+			return
+		}
+		for _, part := range r.Parts {
+			switch {
+			case errors.Is(part.Err, solver.ErrNotUnlocked):
+				fmt.Printf("Day %2d part %d: not yet unlocked\n", r.Day, part.Part)
+			case part.Err != nil:
+				fmt.Printf("Day %2d part %d: error: %v\n", r.Day, part.Part, part.Err)
+				exitCode = 1
+			default:
+				fmt.Printf("Day %2d part %d: %v\n", r.Day, part.Part, part.Stats)
+			}
+		}
+	}
+	if *format != runner.FormatText {
+		printBench = nil
+	}
+	results := runner.RunAll(context.Background(), makeJobs(days, "", ""), opts, printBench)
+	if *format != runner.FormatText {
+		if err := runner.WriteFormat(os.Stdout, *format, runner.Records(results, parts)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing results: %v\n", err)
+			return 1
+		}
+		exitCode = runExitCode(results)
+	}
+	if *exportPath != "" {
+		if err := exportResults(*exportPath, results, parts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *exportPath, err)
+			return 1
+		}
+	}
+	return exitCode
+}
+
+// compareRuns prints how every part changed between two exported runs
+func compareRuns(oldPath, newPath string) int {
+	before, err := runner.ReadRecords(oldPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	after, err := runner.ReadRecords(newPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	if err := runner.WriteComparison(os.Stdout, runner.Compare(before, after)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing comparison: %v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"aoc2025/golden"
+	"aoc2025/puzzle"
+)
+
+func examplesCommand(args []string) int {
+	fs := newFlagSet("examples", "DAY PAGE", "Extract the examples and their answers from a saved puzzle page into golden files\nunder dayN/testdata, which the day's tests run Part1 and Part2 against.")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return 2
+	}
+	day, err := strconv.Atoi(fs.Arg(0))
+	if err != nil || day < 1 || day > lastDay {
+		fmt.Fprintf(os.Stderr, "Invalid day %q, expected 1-%d\n", fs.Arg(0), lastDay)
+		return 2
+	}
+
+	pairs, err := writeGolden(day, fs.Arg(1))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	for _, pair := range pairs {
+		fmt.Printf("Day %d part %d: %s.txt, expecting %s\n", day, pair.Part, pair.Name, pair.Answer)
+	}
+	return 0
+}
+
+// writeGolden extracts the examples of a saved puzzle page into the day's golden files
+func writeGolden(day int, page string) ([]golden.Pair, error) {
+	content, err := puzzle.ReadPage(page)
+	if err != nil {
+		return nil, err
+	}
+	examples := puzzle.Extract(content)
+	if len(examples) == 0 {
+		return nil, fmt.Errorf("%s has no examples with answers", page)
+	}
+	pairs := goldenPairs(examples)
+	return pairs, golden.Save(filepath.Join(fmt.Sprintf("day%d", day), golden.Dir), pairs)
+}
+
+// goldenPairs names the examples, parts sharing an input share a name
+func goldenPairs(examples []puzzle.Example) []golden.Pair {
+	pairs := make([]golden.Pair, 0, len(examples))
+	names := map[string]string{}
+	for _, example := range examples {
+		name, ok := names[example.Input]
+		if !ok {
+			name = "example"
+			if len(names) > 0 {
+				name = fmt.Sprintf("example%d", len(names)+1)
+			}
+			names[example.Input] = name
+		}
+		pairs = append(pairs, golden.Pair{Name: name, Part: example.Part, Input: example.Input, Answer: example.Answer})
+	}
+	return pairs
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"aoc2025/aoc"
+	"aoc2025/registry"
+	"aoc2025/runner"
+)
+
+func fetchCommand(args []string) int {
+	fs := newFlagSet("fetch", "[DAYS]", "Download puzzle inputs to dayN/data.txt. Inputs already on disk, plain or encrypted, are never fetched again.\nThe session cookie is read from "+aoc.SessionEnv+" or the session file.\nDAYS defaults to the registered days.")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	days, err := fetchDays(fs.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	session, err := aoc.LoadSession()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	client := aoc.NewClient(session)
+
+	code := 0
+	for _, day := range days {
+		path := runner.InputPath(day)
+		fetched, err := client.FetchToFile(context.Background(), year, day, path)
+		switch {
+		case errors.Is(err, aoc.ErrUnauthorized):
+			fmt.Fprintf(os.Stderr, "Day %2d: %v\n", day, err)
+			return 1
+		case err != nil:
+			fmt.Fprintf(os.Stderr, "Day %2d: %v\n", day, err)
+			code = 1
+		case fetched:
+			fmt.Printf("Day %2d: saved %s\n", day, path)
+		default:
+			fmt.Printf("Day %2d: %s already present\n", day, path)
+		}
+	}
+	return code
+}
+
+// fetchDays resolves the days to fetch, which need not be registered yet
+func fetchDays(args []string) ([]int, error) {
+	if len(args) == 0 {
+		days := []int{}
+		for _, entry := range registry.All() {
+			if entry.Year == year {
+				days = append(days, entry.Day)
+			}
+		}
+		return days, nil
+	}
+	available := make([]int, lastDay)
+	for i := range available {
+		available[i] = i + 1
+	}
+	return runner.ParseDays(strings.Join(args, ","), available)
+}
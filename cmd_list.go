@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"aoc2025/registry"
+	"aoc2025/runner"
+	"aoc2025/vault"
+)
+
+func listCommand(args []string) int {
+	fs := newFlagSet("list", "", "List the registered days, their titles and whether their input is present.")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return 2
+	}
+
+	fmt.Printf("%-4s %-4s %-24s %s\n", "Year", "Day", "Title", "Input")
+	for _, entry := range registry.All() {
+		input := "missing"
+		if _, err := os.Stat(runner.InputPath(entry.Day)); err == nil {
+			input = runner.InputPath(entry.Day)
+		} else if _, err := os.Stat(runner.InputPath(entry.Day) + vault.Ext); err == nil {
+			input = runner.InputPath(entry.Day) + vault.Ext
+		}
+		fmt.Printf("%-4d %-4d %-24s %s\n", entry.Year, entry.Day, entry.Title, input)
+	}
+	return 0
+}
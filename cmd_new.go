@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"aoc2025/puzzle"
+)
+
+func newCommand(args []string) int {
+	fs := newFlagSet("new", "[flags] DAY", "Create the package for a new day from the templates and register it with the runner.\nThe test file can start from an example taken from a saved puzzle page, whose examples\nand answers are also written as golden files.")
+	title := fs.String("title", "", "puzzle `title` to register the day with")
+	page := fs.String("page", "", "saved puzzle page `file` to take the example input for the test from")
+	exampleIndex := fs.Int("example", 1, "which `N`th example block of the page to use")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+	day, err := strconv.Atoi(fs.Arg(0))
+	if err != nil || day < 1 || day > lastDay {
+		fmt.Fprintf(os.Stderr, "Invalid day %q, expected 1-%d\n", fs.Arg(0), lastDay)
+		return 2
+	}
+
+	example := ""
+	if *page != "" {
+		example, err = pageExample(*page, *exampleIndex)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return 1
+		}
+	}
+
+	if err := scaffoldDay(day, *title, example); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	if *page != "" {
+		if _, err := writeGolden(day, *page); err != nil {
+			fmt.Fprintf(os.Stderr, "No golden files: %v\n", err)
+		}
+	}
+	fmt.Printf("Created day%d, put your input in day%d/data.txt\n", day, day)
+	return 0
+}
+
+//go:embed templates/*.tmpl
+var templateFiles embed.FS
+
+var dayTemplates = template.Must(template.New("").Funcs(template.FuncMap{
+	"goString": goString,
+}).ParseFS(templateFiles, "templates/*.tmpl"))
+
+// dayTemplateData is what the day templates are filled in with
+type dayTemplateData struct {
+	Day     int
+	Year    int
+	Title   string
+	Example string
+}
+
+type dayFile struct {
+	name     string
+	template string
+}
+
+// dayFiles lists the files of a new day package and the templates they come from
+func dayFiles(day int) []dayFile {
+	return []dayFile{
+		{"day.go", "day.go.tmpl"},
+		{fmt.Sprintf("day%d.go", day), "dayN.go.tmpl"},
+		{fmt.Sprintf("day%d_test.go", day), "dayN_test.go.tmpl"},
+	}
+}
+
+// goString writes s as a Go string literal, a raw string when it can be
+func goString(s string) string {
+	if strings.Contains(s, "`") || strings.Contains(s, "\r") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+// scaffoldDay writes the day package from the templates and adds it to days.go
+// the day directory is removed again if any step fails
+func scaffoldDay(day int, title, example string) (err error) {
+	dir := fmt.Sprintf("day%d", day)
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("%s already exists", dir)
+	}
+	if title == "" {
+		title = fmt.Sprintf("Day %d", day)
+	}
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	data := dayTemplateData{Day: day, Year: year, Title: title, Example: example}
+	for _, file := range dayFiles(day) {
+		var buf bytes.Buffer
+		if err := dayTemplates.ExecuteTemplate(&buf, file.template, data); err != nil {
+			return err
+		}
+		formatted, err := format.Source(buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("formatting %s: %w", file.name, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, file.name), formatted, 0o644); err != nil {
+			return err
+		}
+	}
+	return addDayImport(day)
+}
+
+// pageExample takes the nth example block from a saved puzzle page
+func pageExample(path string, n int) (string, error) {
+	page, err := puzzle.ReadPage(path)
+	if err != nil {
+		return "", err
+	}
+	examples := puzzle.Examples(page)
+	if n < 1 || n > len(examples) {
+		return "", fmt.Errorf("%s has %d example blocks, no example %d", path, len(examples), n)
+	}
+	return examples[n-1], nil
+}
+
+// addDayImport adds the blank import of a day package to days.go
+func addDayImport(day int) error {
+	const daysFile = "days.go"
+	data, err := os.ReadFile(daysFile)
+	if err != nil {
+		return err
+	}
+	content := string(data)
+	importLine := fmt.Sprintf("\t_ \"aoc2025/day%d\"\n", day)
+	if strings.Contains(content, importLine) {
+		return nil
+	}
+	end := strings.LastIndex(content, ")")
+	if end == -1 {
+		return fmt.Errorf("no import block in %s", daysFile)
+	}
+	formatted, err := format.Source([]byte(content[:end] + importLine + content[end:]))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(daysFile, formatted, 0o644)
+}
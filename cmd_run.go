@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"aoc2025/registry"
+	"aoc2025/runner"
+	"aoc2025/solver"
+	"aoc2025/watch"
+)
+
+func runCommand(args []string) int {
+	fs := newFlagSet("run", "[flags] [DAYS]", "Run the selected days in day order and print their answers, followed by a summary table.")
+	partsFlag := fs.String("parts", "", "parts to run, 1, 2 or 1,2 (default both)")
+	inputFlag := fs.String("input", "", "read the puzzle input from `path` instead of dayN/data.txt, - for stdin, needs a single day")
+	setFlag := fs.String("set", "", "read the puzzle inputs from the input set `name`, inputs/<name>/dayN.txt")
+	matrix := fs.Bool("matrix", false, "run every day against every input set and show the answers side by side")
+	parallel := fs.Int("parallel", 1, "run up to `N` days at the same time, output stays in day order")
+	summary := fs.Bool("summary", true, "print a summary table after the answers")
+	repeat := fs.Int("repeat", 1, "run each part `N` times and report min, median and p95 times")
+	exportPath := fs.String("export", "", "also write the results as JSON to `path`")
+	format := fs.String("format", runner.FormatText, "output `format`: text, json, csv or markdown")
+	timeout := fs.Duration("timeout", 0, "cancel a part after `duration`, such as 30s (default no limit)")
+	profile := profileFlags(fs)
+	watchFlag := fs.Bool("watch", false, "rerun the days and their tests whenever their packages, the packages they import or their inputs change")
+	poll := fs.Duration("poll", watch.DefaultInterval, "how often -watch checks for changes")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	days, err := selectDays(fs.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	parts, err := runner.ParseParts(*partsFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 2
+	}
+	if *inputFlag != "" && len(days) != 1 {
+		fmt.Fprintf(os.Stderr, "-input needs a single day, got %d\n", len(days))
+		return 2
+	}
+	if (*inputFlag != "" && *setFlag != "") || (*matrix && (*inputFlag != "" || *setFlag != "")) {
+		fmt.Fprintf(os.Stderr, "-input, -set and -matrix cannot be combined\n")
+		return 2
+	}
+	if err := runner.CheckFormat(*format); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 2
+	}
+	if *parallel < 1 || *repeat < 1 {
+		fmt.Fprintf(os.Stderr, "-parallel and -repeat must be at least 1\n")
+		return 2
+	}
+	if profile.Enabled() && *parallel > 1 {
+		fmt.Fprintf(os.Stderr, "profiles cover the whole process, they cannot be combined with -parallel\n")
+		return 2
+	}
+
+	if *watchFlag {
+		if *matrix || *inputFlag == runner.Stdin {
+			fmt.Fprintf(os.Stderr, "-watch cannot be combined with -matrix or reading stdin\n")
+			return 2
+		}
+		return runWatch(watchConfig{days: days, parts: *partsFlag, input: *inputFlag, set: *setFlag, timeout: *timeout, interval: *poll})
+	}
+
+	opts := runner.Options{Parts: parts, Parallel: *parallel, Repeat: *repeat, Timeout: *timeout, Profile: *profile}
+	if *matrix {
+		return runMatrix(days, opts, *format, *exportPath)
+	}
+
+	jobs := makeJobs(days, *inputFlag, *setFlag)
+	if *format != runner.FormatText {
+		results := runner.RunAll(context.Background(), jobs, opts, nil)
+		if err := runner.WriteFormat(os.Stdout, *format, runner.Records(results, parts)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing results: %v\n", err)
+			return 1
+		}
+		return finishRun(results, parts, *exportPath)
+	}
+
+	results := runner.RunAll(context.Background(), jobs, opts, printDayResult)
+	if *summary {
+		runner.WriteSummary(os.Stdout, results)
+	}
+	return finishRun(results, parts, *exportPath)
+}
+
+// finishRun writes the export file if asked for, and picks the exit code
+func finishRun(results []runner.DayResult, parts []int, exportPath string) int {
+	if exportPath != "" {
+		if err := exportResults(exportPath, results, parts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", exportPath, err)
+			return 1
+		}
+	}
+	return runExitCode(results)
+}
+
+// runExitCode is 1 if any day had no input or any part failed
+func runExitCode(results []runner.DayResult) int {
+	for _, r := range results {
+		if r.InputErr != nil {
+			return 1
+		}
+		for _, part := range r.Parts {
+			if part.Err != nil && !errors.Is(part.Err, solver.ErrNotUnlocked) {
+				return 1
+			}
+		}
+	}
+	return 0
+}
+
+// runMatrix runs every day against every input set
+func runMatrix(days []registry.Entry, opts runner.Options, format, exportPath string) int {
+	sets, err := runner.ListSets()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error listing input sets: %v\n", err)
+		return 1
+	}
+	jobs := []runner.Job{}
+	for _, day := range days {
+		for _, set := range sets {
+			jobs = append(jobs, makeJobs([]registry.Entry{day}, "", set)...)
+		}
+	}
+
+	results := runner.RunAll(context.Background(), jobs, opts, nil)
+	if format == runner.FormatText {
+		err = runner.WriteMatrix(os.Stdout, results, sets, opts.Parts)
+	} else {
+		err = runner.WriteFormat(os.Stdout, format, runner.Records(results, opts.Parts))
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing results: %v\n", err)
+		return 1
+	}
+
+	// a set does not need an input for every day, so missing inputs are not failures here
+	if exportPath != "" {
+		if err := exportResults(exportPath, results, opts.Parts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", exportPath, err)
+			return 1
+		}
+	}
+	for _, r := range results {
+		for _, part := range r.Parts {
+			if part.Err != nil && !errors.Is(part.Err, solver.ErrNotUnlocked) {
+				return 1
+			}
+		}
+	}
+	return 0
+}
+
+// makeJobs loads the input of every day, from inputPath if given,
+// otherwise from the named input set
+// days that stream their input get an opener instead of the loaded input
+func makeJobs(days []registry.Entry, inputPath, set string) []runner.Job {
+	jobs := make([]runner.Job, 0, len(days))
+	for _, day := range days {
+		path, name := inputPath, inputPath
+		if path == "" {
+			path = runner.SetPath(set, day.Day)
+			name = set
+			if name == "" {
+				name = runner.DefaultSet
+			}
+		}
+		job := runner.Job{Day: day.Day, Title: day.Title, Solver: day.Solver, InputName: name}
+		if _, ok := day.Solver.(solver.Streamer); ok {
+			job.Open, job.InputErr = runner.OpenInput(day.Day, path)
+		} else {
+			job.Input, job.InputErr = runner.ReadInput(day.Day, path)
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
+
+func printDayResult(r runner.DayResult) {
+	if r.InputErr != nil {
+		fmt.Fprintf(os.Stderr, "Error reading input for day %d: %v\n", r.Day, r.InputErr)
+		return
+	}
+	fmt.Printf("Day %d: %s\n", r.Day, r.Title)
+	for _, part := range r.Parts {
+		printResult(part)
+	}
+	fmt.Println()
+}
+
+func printResult(result runner.Result) {
+	switch {
+	case errors.Is(result.Err, solver.ErrNotUnlocked):
+		fmt.Printf("  Part %d: Not yet unlocked\n", result.Part)
+	case errors.Is(result.Err, context.DeadlineExceeded):
+		fmt.Printf("  Part %d: timed out\n", result.Part)
+	case result.Err != nil:
+		fmt.Printf("  Part %d: error: %v\n", result.Part, result.Err)
+		if stack := runner.PanicStack(result.Err); stack != "" {
+			fmt.Printf("    %s\n", strings.ReplaceAll(strings.TrimSpace(stack), "\n", "\n    "))
+		}
+	default:
+		fmt.Printf("  Part %d: %v  (%v)\n", result.Part, result.Answer, result.Stats)
+	}
+}
+
+// exportResults writes the results as JSON records to path
+func exportResults(path string, results []runner.DayResult, parts []int) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := runner.WriteJSON(f, runner.Records(results, parts)); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
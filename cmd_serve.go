@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"aoc2025/registry"
+	"aoc2025/server"
+)
+
+func serveCommand(args []string) int {
+	fs := newFlagSet("serve", "[flags]", "Serve the registered days over HTTP.\n\n  GET  /days                           list the days and whether they are unlocked\n  POST /years/{y}/days/{n}/parts/{p}    run a part on the input in the request body\n  POST /days/{n}/parts/{p}             the same for the current year\n\nA timeout query parameter, such as ?timeout=5s, shortens -timeout for one request.")
+	addr := fs.String("addr", "localhost:8025", "`address` to listen on")
+	timeout := fs.Duration("timeout", 30*time.Second, "cancel a part after `duration`, 0 for no limit")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return 2
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           server.New(registry.All(), server.Options{Timeout: *timeout, Year: year}),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(_ net.Listener) context.Context { return ctx },
+	}
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdown)
+	}()
+
+	fmt.Printf("Serving %d days on http://%s\n", len(registry.All()), *addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	return 0
+}
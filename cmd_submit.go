@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"aoc2025/aoc"
+	"aoc2025/registry"
+	"aoc2025/runner"
+)
+
+func submitCommand(args []string) int {
+	fs := newFlagSet("submit", "[flags] DAY PART", "Run one part of a day and submit its answer.\nEvery guess is kept in the history file, and answers that were already rejected\nor fall outside the too high and too low bounds of past guesses are not submitted.")
+	historyPath := fs.String("history", aoc.DefaultHistoryPath, "guess history `file`")
+	timeout := fs.Duration("timeout", 0, "cancel the part after `duration`, such as 30s (default no limit)")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return 2
+	}
+	day, dayErr := strconv.Atoi(fs.Arg(0))
+	part, partErr := strconv.Atoi(fs.Arg(1))
+	if dayErr != nil || partErr != nil || (part != 1 && part != 2) {
+		fmt.Fprintf(os.Stderr, "Invalid day %q or part %q\n", fs.Arg(0), fs.Arg(1))
+		return 2
+	}
+	entry, ok := registry.Lookup(year, day)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Day %d is not registered\n", day)
+		return 1
+	}
+
+	input, err := runner.ReadInput(day, runner.InputPath(day))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading input for day %d: %v\n", day, err)
+		return 1
+	}
+	result := runner.RunPart(context.Background(), entry.Solver, day, part, input, runner.Options{Repeat: 1, Timeout: *timeout})
+	fmt.Printf("Day %d: %s\n", day, entry.Title)
+	printResult(result)
+	if result.Err != nil {
+		return 1
+	}
+	answer := result.Answer.String()
+
+	history, err := aoc.LoadHistory(*historyPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	if err := history.Check(day, part, answer); err != nil {
+		fmt.Fprintf(os.Stderr, "Not submitting: %v\n", err)
+		if errors.Is(err, aoc.ErrAlreadyCorrect) {
+			return 0
+		}
+		return 1
+	}
+	session, err := aoc.LoadSession()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+
+	response, err := aoc.NewClient(session).Submit(context.Background(), year, day, part, answer)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	// every guess is kept, Check only lets judged ones block a resubmit
+	history.Add(aoc.Guess{Day: day, Part: part, Answer: answer, Verdict: response.Verdict, Time: time.Now().UTC()})
+	if err := history.Save(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error saving %s: %v\n", *historyPath, err)
+	}
+
+	switch response.Verdict {
+	case aoc.Correct:
+		fmt.Printf("Submitted %s: correct\n", answer)
+		return 0
+	case aoc.RateLimited:
+		fmt.Printf("Submitted %s: rate limited, wait %v\n", answer, response.Wait)
+	case aoc.Unknown:
+		fmt.Printf("Submitted %s: could not read the reply: %s\n", answer, response.Message)
+	default:
+		fmt.Printf("Submitted %s: %s\n", answer, response.Verdict)
+		if response.Wait > 0 {
+			fmt.Printf("Wait %v before the next guess\n", response.Wait)
+		}
+	}
+	return 1
+}
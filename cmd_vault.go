@@ -0,0 +1,208 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"aoc2025/runner"
+	"aoc2025/vault"
+)
+
+func encryptCommand(args []string) int {
+	fs := newFlagSet("encrypt", "[flags] [DAYS]", "Encrypt dayN/data.txt and the named sets' inputs/SET/dayN.txt to a .enc copy with the input key,\nso inputs can be committed.\nThe key is read from "+vault.KeyEnv+" (hex) or the key file named by "+vault.KeyFileEnv+", "+vault.DefaultKeyFile+" by default.")
+	genkey := fs.Bool("genkey", false, "write a new random key to the key file when there is no key yet")
+	set := fs.String("set", "", "only encrypt the input `set` named, data for dayN/data.txt (default every set)")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	paths, err := vaultInputs(fs.Args(), *set)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	key, err := vault.LoadKey()
+	if errors.Is(err, vault.ErrNoKey) && *genkey {
+		key = vault.GenerateKey()
+		err = vault.WriteKeyFile(vault.KeyFile(), key)
+		if err == nil {
+			fmt.Printf("Wrote a new key to %s, keep it out of the repository\n", vault.KeyFile())
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+
+	code := 0
+	for _, path := range paths {
+		plaintext, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err == nil {
+			err = vault.WriteFile(key, path+vault.Ext, plaintext)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			code = 1
+			continue
+		}
+		fmt.Printf("Encrypted %s\n", path+vault.Ext)
+	}
+	return code
+}
+
+// vaultInputs lists the plain text paths of the days in args for the input set
+// named, or for every set, the default one and those under inputs, when set is empty
+func vaultInputs(args []string, set string) ([]string, error) {
+	days, err := selectDays(args)
+	if err != nil {
+		return nil, err
+	}
+	sets := []string{set}
+	if set == "" {
+		if sets, err = runner.ListSets(); err != nil {
+			return nil, err
+		}
+	}
+	paths := []string{}
+	for _, set := range sets {
+		for _, day := range days {
+			paths = append(paths, runner.SetPath(set, day.Day))
+		}
+	}
+	return paths, nil
+}
+
+func decryptCommand(args []string) int {
+	fs := newFlagSet("decrypt", "[flags] [DAYS]", "Decrypt the .enc copies of dayN/data.txt and inputs/SET/dayN.txt back to plain text with the input key.\nThe runner decrypts in memory, this is only needed to edit or inspect an input.")
+	force := fs.Bool("force", false, "overwrite inputs that already exist in plain text")
+	set := fs.String("set", "", "only decrypt the input `set` named, data for dayN/data.txt (default every set)")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	paths, err := vaultInputs(fs.Args(), *set)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	key, err := vault.LoadKey()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+
+	code := 0
+	for _, path := range paths {
+		if _, err := os.Stat(path + vault.Ext); err != nil {
+			continue
+		}
+		if _, err := os.Stat(path); err == nil && !*force {
+			fmt.Printf("%s already present\n", path)
+			continue
+		}
+		plaintext, err := vault.ReadFile(key, path+vault.Ext)
+		if err == nil {
+			err = os.WriteFile(path, plaintext, 0o600)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			code = 1
+			continue
+		}
+		fmt.Printf("Decrypted %s\n", path)
+	}
+	return code
+}
+
+func rekeyCommand(args []string) int {
+	fs := newFlagSet("rekey", "-new-key FILE [DAYS]", "Re-encrypt every encrypted input, of every input set, from the current key to a new one.\nEvery input is decrypted and re-encrypted to a temporary file before any is replaced,\nso a wrong current key or a failed write changes nothing.")
+	newKeyFile := fs.String("new-key", "", "key `file` to re-encrypt with, a new random key is written to it if it does not exist")
+	set := fs.String("set", "", "only re-encrypt the input `set` named, data for dayN/data.txt (default every set)")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	if *newKeyFile == "" {
+		fs.Usage()
+		return 2
+	}
+	inputs, err := vaultInputs(fs.Args(), *set)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	oldKey, err := vault.LoadKey()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+
+	paths := []string{}
+	plaintexts := [][]byte{}
+	for _, input := range inputs {
+		path := input + vault.Ext
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		plaintext, err := vault.ReadFile(oldKey, path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return 1
+		}
+		paths = append(paths, path)
+		plaintexts = append(plaintexts, plaintext)
+	}
+
+	newKey, err := vault.ReadKeyFile(*newKeyFile)
+	if errors.Is(err, vault.ErrNoKey) {
+		newKey = vault.GenerateKey()
+		err = vault.WriteKeyFile(*newKeyFile, newKey)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	temps, err := writeTemps(newKey, paths, plaintexts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	for i, path := range paths {
+		if err := os.Rename(temps[i], path); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			for _, temp := range temps[i:] {
+				os.Remove(temp)
+			}
+			return 1
+		}
+		fmt.Printf("Re-encrypted %s\n", path)
+	}
+	fmt.Printf("Inputs now use the key in %s, point %s at it or replace %s\n", *newKeyFile, vault.KeyFileEnv, vault.KeyFile())
+	return 0
+}
+
+// writeTemps encrypts every plaintext to a temporary file beside its path
+// if any write fails the temporary files are removed and nothing else changes
+func writeTemps(key []byte, paths []string, plaintexts [][]byte) ([]string, error) {
+	temps := []string{}
+	for i, path := range paths {
+		temp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+		if err == nil {
+			temps = append(temps, temp.Name())
+			temp.Close()
+			err = os.Chmod(temp.Name(), 0o644)
+		}
+		if err == nil {
+			err = vault.WriteFile(key, temps[len(temps)-1], plaintexts[i])
+		}
+		if err != nil {
+			for _, temp := range temps {
+				os.Remove(temp)
+			}
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return temps, nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"aoc2025/answers"
+	"aoc2025/runner"
+	"aoc2025/solver"
+)
+
+func verifyCommand(args []string) int {
+	fs := newFlagSet("verify", "[flags] [DAYS]", "Run the selected days and compare every answer with the known answers for its input.\nExits non-zero on any mismatch or error.")
+	answersPath := fs.String("answers", answers.DefaultPath, "known answers `file`")
+	record := fs.Bool("record", false, "store the answers of parts that have no known answer yet")
+	strict := fs.Bool("strict", false, "also fail when a part has no known answer")
+	timeout := fs.Duration("timeout", 0, "cancel a part after `duration`, such as 30s (default no limit)")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	days, err := selectDays(fs.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	store, err := answers.Load(*answersPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+
+	jobs := makeJobs(days, "", "")
+	results := runner.RunAll(context.Background(), jobs, runner.Options{Parallel: 1, Repeat: 1, Timeout: *timeout}, nil)
+
+	counts := map[string]int{}
+	for i, r := range results {
+		if r.InputErr != nil {
+			fmt.Printf("Day %2d: FAIL reading input: %v\n", r.Day, r.InputErr)
+			counts["fail"]++
+			continue
+		}
+		inputHash, err := hashJobInput(jobs[i])
+		if err != nil {
+			fmt.Printf("Day %2d: FAIL reading input: %v\n", r.Day, err)
+			counts["fail"]++
+			continue
+		}
+		for _, part := range r.Parts {
+			if errors.Is(part.Err, solver.ErrNotUnlocked) {
+				fmt.Printf("Day %2d part %d: skipped, not yet unlocked\n", r.Day, part.Part)
+				continue
+			}
+			if part.Err != nil {
+				fmt.Printf("Day %2d part %d: FAIL %v\n", r.Day, part.Part, part.Err)
+				counts["fail"]++
+				continue
+			}
+			answer := part.Answer.String()
+			outcome, known := store.Check(r.Day, part.Part, inputHash, answer)
+			switch outcome {
+			case answers.Pass:
+				fmt.Printf("Day %2d part %d: pass\n", r.Day, part.Part)
+			case answers.Fail:
+				fmt.Printf("Day %2d part %d: FAIL got %s, expected %s\n", r.Day, part.Part, answer, known)
+			case answers.Missing:
+				if *record {
+					store.Set(r.Day, part.Part, inputHash, answer)
+					fmt.Printf("Day %2d part %d: recorded %s\n", r.Day, part.Part, answer)
+					counts["recorded"]++
+					continue
+				}
+				fmt.Printf("Day %2d part %d: missing, got %s\n", r.Day, part.Part, answer)
+			}
+			counts[string(outcome)]++
+		}
+	}
+
+	if counts["recorded"] > 0 {
+		if err := store.Save(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *answersPath, err)
+			return 1
+		}
+	}
+	fmt.Printf("%d passed, %d failed, %d missing, %d recorded\n", counts["pass"], counts["fail"], counts["missing"], counts["recorded"])
+	if counts["fail"] > 0 || (*strict && counts["missing"] > 0) {
+		return 1
+	}
+	return 0
+}
+
+// hashJobInput hashes the input of a job, reading it again for days that stream it
+func hashJobInput(job runner.Job) (string, error) {
+	if job.Open == nil {
+		return answers.HashInput(job.Input), nil
+	}
+	r, err := job.Open()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	return answers.HashReader(r)
+}
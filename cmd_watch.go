@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"aoc2025/registry"
+	"aoc2025/runner"
+	"aoc2025/vault"
+	"aoc2025/watch"
+)
+
+// watchConfig is what a watch loop passes on to every rerun
+type watchConfig struct {
+	days     []registry.Entry
+	parts    string
+	input    string
+	set      string
+	timeout  time.Duration
+	interval time.Duration
+}
+
+// runWatch reruns the days and their tests whenever their packages, the packages
+// they import from this module, or their inputs change
+// each rerun builds a fresh binary with go run, so code changes are picked up
+func runWatch(config watchConfig) int {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	paths := watchPaths(ctx, config)
+	fmt.Printf("Watching %s, press Ctrl-C to stop\n", strings.Join(paths, ", "))
+
+	previous := map[string]string{}
+	changed := []string{}
+	for {
+		fmt.Printf("\n== %s", time.Now().Format("15:04:05"))
+		if len(changed) > 0 {
+			fmt.Printf(" (%s changed)", strings.Join(changed, ", "))
+		}
+		fmt.Println()
+		// imports may have changed, and files saved during the rerun must count
+		paths = watchPaths(ctx, config)
+		before, err := watch.Take(paths)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error watching: %v\n", err)
+			return 1
+		}
+		previous = rerunDays(ctx, config, previous)
+		rerunTests(ctx, config.days)
+
+		changed, err = watch.Wait(ctx, paths, before, config.interval)
+		if errors.Is(err, context.Canceled) {
+			return 0
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error watching: %v\n", err)
+			return 1
+		}
+	}
+}
+
+// watchPaths lists the inputs of the days and the directories of every package
+// of this module they depend on, such as input or grid
+// if go list fails, say on a syntax error, it falls back to the day directories
+func watchPaths(ctx context.Context, config watchConfig) []string {
+	paths := []string{}
+	packages := []string{"list", "-deps", "-f", "{{if .Module}}{{if .Module.Main}}{{.Dir}}{{end}}{{end}}"}
+	for _, day := range config.days {
+		input := config.input
+		if input == "" {
+			input = runner.SetPath(config.set, day.Day)
+		}
+		paths = append(paths, input, input+vault.Ext)
+		packages = append(packages, fmt.Sprintf("./day%d", day.Day))
+	}
+
+	output, err := exec.CommandContext(ctx, "go", packages...).Output()
+	if err != nil {
+		for _, day := range config.days {
+			paths = append(paths, fmt.Sprintf("day%d", day.Day))
+		}
+		return paths
+	}
+	cwd, _ := os.Getwd()
+	for _, dir := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if rel, err := filepath.Rel(cwd, dir); err == nil {
+			dir = rel
+		}
+		if !slices.Contains(paths, dir) {
+			paths = append(paths, dir)
+		}
+	}
+	return paths
+}
+
+// rerunDays runs the days in a fresh build and prints their answers against the previous ones
+// it returns the answers of this run, keyed by day and part
+func rerunDays(ctx context.Context, config watchConfig, previous map[string]string) map[string]string {
+	args := []string{"run", ".", "run", "-format", runner.FormatJSON, "-summary=false"}
+	if config.parts != "" {
+		args = append(args, "-parts", config.parts)
+	}
+	if config.input != "" {
+		args = append(args, "-input", config.input)
+	}
+	if config.set != "" {
+		args = append(args, "-set", config.set)
+	}
+	if config.timeout > 0 {
+		args = append(args, "-timeout", config.timeout.String())
+	}
+	for _, day := range config.days {
+		args = append(args, strconv.Itoa(day.Day))
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	cmd.Run()
+	records := []runner.Record{}
+	if err := json.Unmarshal(stdout.Bytes(), &records); err != nil {
+		fmt.Printf("Build failed:\n%s\n", indent(stderr.String()))
+		return previous
+	}
+
+	current := map[string]string{}
+	for _, record := range records {
+		key := fmt.Sprintf("Day %d part %d", record.Day, record.Part)
+		answer := record.Status
+		if !record.Answer.IsZero() {
+			answer = record.Answer.String()
+		} else if record.Error != "" {
+			answer = record.Status + ": " + record.Error
+		}
+		current[key] = answer
+		was, seen := previous[key]
+		switch {
+		case !seen:
+			fmt.Printf("+ %s: %s\n", key, answer)
+		case was != answer:
+			fmt.Printf("~ %s: %s (was %s)\n", key, answer, was)
+		default:
+			fmt.Printf("  %s: %s\n", key, answer)
+		}
+	}
+	return current
+}
+
+// rerunTests runs the tests of the day packages, printing their output only when they fail
+func rerunTests(ctx context.Context, days []registry.Entry) {
+	args := []string{"test"}
+	for _, day := range days {
+		args = append(args, fmt.Sprintf("./day%d", day.Day))
+	}
+	output, err := exec.CommandContext(ctx, "go", args...).CombinedOutput()
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		fmt.Printf("Tests: FAIL\n%s\n", indent(string(output)))
+		return
+	}
+	fmt.Println("Tests: ok")
+}
+
+func indent(text string) string {
+	return "    " + strings.ReplaceAll(strings.TrimSpace(text), "\n", "\n    ")
+}
@@ -1,6 +1,12 @@
 package main
 
+import "aoc2025/solver"
+
 // Day represents a single day's solution for Advent of Code
+//
+// Deprecated: implement solver.Solver instead. Days written against this
+// interface are registered with registry.RegisterLegacy, which runs them
+// through solver.FromLegacy.
 type Day interface {
 	// Part1 solves part 1 of the day's puzzle
 	// Returns any printable value
@@ -9,3 +15,6 @@ type Day interface {
 	// Returns the result and true if implemented, nil and false if not yet unlocked
 	Part2(input string) (interface{}, bool)
 }
+
+// a Day can always be registered with registry.RegisterLegacy
+var _ solver.Legacy = Day(nil)
@@ -1,15 +1,41 @@
 package day1
 
-// Day1 implements the Day interface for day 1
+import (
+	"context"
+	"io"
+
+	"aoc2025/registry"
+	"aoc2025/solver"
+)
+
+// Day1 implements the Solver interface for day 1
 type Day1 struct{}
 
-// Part1 implements the Day interface
-func (d Day1) Part1(input string) interface{} {
-	return SolveDay1Part1(input)
+func init() {
+	registry.Register(registry.Entry{Year: 2025, Day: 1, Title: "Secret Entrance", Solver: Day1{}})
+}
+
+// Part1 implements the Solver interface
+func (d Day1) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay1Part1(input)
+	return solver.Int(answer), err
+}
+
+// Part2 implements the Solver interface
+func (d Day1) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay1Part2(input)
+	return solver.Int(answer), err
+	// Return (solver.Answer{}, solver.ErrNotUnlocked) if Part 2 is not yet unlocked
+}
+
+// Part1Reader implements the Streamer interface
+func (d Day1) Part1Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	answer, err := SolveDay1Part1Reader(r)
+	return solver.Int(answer), err
 }
 
-// Part2 implements the Day interface
-func (d Day1) Part2(input string) (interface{}, bool) {
-	return SolveDay1Part2(input), true
-	// Return (nil, false) if Part 2 is not yet unlocked
+// Part2Reader implements the Streamer interface
+func (d Day1) Part2Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	answer, err := SolveDay1Part2Reader(r)
+	return solver.Int(answer), err
 }
@@ -1,8 +1,11 @@
 package day1
 
 import (
-	"strconv"
+	"fmt"
+	"io"
 	"strings"
+
+	"aoc2025/input"
 )
 
 type Direction int
@@ -66,77 +69,76 @@ func (d *DialValue) TurnAndCountZeros(direction Direction, steps int) (DialValue
 	return newValue, passes
 }
 
+// Rotation is one line of input, a direction and the number of steps to turn
+type Rotation struct {
+	Direction Direction
+	Steps     int
+}
+
+// parseRotation reads a line in the format "DS" where D is either "R" or "L"
+// and S is the number of steps to turn
+func parseRotation(line string) (Rotation, error) {
+	line = strings.TrimSpace(line)
+	if len(line) < 2 {
+		return Rotation{}, &input.Error{Column: 1, Err: fmt.Errorf("invalid rotation %q, expected L or R and a number of steps", line)}
+	}
+	// First character is direction (L or R)
+	direction := Clockwise
+	switch line[0] {
+	case 'R':
+	case 'L':
+		direction = CounterClockwise
+	default:
+		return Rotation{}, &input.Error{Column: 1, Err: fmt.Errorf("invalid direction %q, expected L or R", line[0])}
+	}
+	// Rest is the number of steps
+	steps, err := input.Int(line[1:])
+	if err != nil {
+		return Rotation{}, input.Offset(err, 1)
+	}
+	return Rotation{Direction: direction, Steps: steps}, nil
+}
+
 // SolveDay1 starts with a value kDialStartValue on the dial
 // Then take each line as string and convert to a DialRotation
 // Each line is in the format "DS" where D is either "R" or "L" and S is the number of steps to turn
 // After each rotation, if the dial lands on 0, we increment a counter
 // Finally, return the counter
-func SolveDay1Part1(input string) int {
-	result := 0
+func SolveDay1Part1(input string) (int, error) {
+	return SolveDay1Part1Reader(strings.NewReader(input))
+}
 
+// SolveDay1Part1Reader is SolveDay1Part1 reading one rotation at a time from r
+func SolveDay1Part1Reader(r io.Reader) (int, error) {
+	result := 0
 	dial := DialValue(kDialStartValue)
 
-	for _, line := range strings.Split(input, "\n") {
-		line = strings.TrimSpace(line)
-		if len(line) < 2 {
-			continue // skip invalid lines
-		}
-
-		// First character is direction (L or R)
-		dirChar := line[0]
-		// Rest is the number of steps
-		stepsStr := line[1:]
-
-		steps, err := strconv.Atoi(stepsStr)
-		if err != nil {
-			continue // skip invalid lines
-		}
-
-		var dir Direction
-		switch dirChar {
-		case 'R':
-			dir = Clockwise
-		case 'L':
-			dir = CounterClockwise
-		default:
-			continue // skip invalid lines
-		}
-
-		if dial.Turn(dir, steps) == 0 {
+	err := input.Each(input.NewScanner(r, input.Strict), parseRotation, func(rotation Rotation) {
+		if dial.Turn(rotation.Direction, rotation.Steps) == 0 {
 			result++
 		}
+	})
+	if err != nil {
+		return 0, err
 	}
-	return result
+	return result, nil
 }
 
-func SolveDay1Part2(input string) int {
+func SolveDay1Part2(input string) (int, error) {
+	return SolveDay1Part2Reader(strings.NewReader(input))
+}
+
+// SolveDay1Part2Reader is SolveDay1Part2 reading one rotation at a time from r
+func SolveDay1Part2Reader(r io.Reader) (int, error) {
 	result := 0
 	dial := DialValue(kDialStartValue)
 
-	for _, line := range strings.Split(input, "\n") {
-		line = strings.TrimSpace(line)
-		if len(line) < 2 {
-			continue // skip invalid lines
-		}
-		// First character is direction (L or R)
-		dirChar := line[0]
-		// Rest is the number of steps
-		stepsStr := line[1:]
-		steps, err := strconv.Atoi(stepsStr)
-		if err != nil {
-			continue // skip invalid lines
-		}
-		var dir Direction
-		switch dirChar {
-		case 'R':
-			dir = Clockwise
-		case 'L':
-			dir = CounterClockwise
-		default:
-			continue // skip invalid lines
-		}
-		_, passes := dial.TurnAndCountZeros(dir, steps)
+	err := input.Each(input.NewScanner(r, input.Strict), parseRotation, func(rotation Rotation) {
+		_, passes := dial.TurnAndCountZeros(rotation.Direction, rotation.Steps)
 		result += passes
+	})
+	if err != nil {
+		return 0, err
 	}
-	return result
+	return result, nil
 }
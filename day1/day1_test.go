@@ -1,7 +1,13 @@
 package day1
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
+
+	"aoc2025/golden"
+	"aoc2025/input"
 )
 
 const sampleInput = `L68
@@ -42,7 +48,10 @@ func TestSolveDay1Part1(t *testing.T) {
 
 	const expectedSampleOutput = 3
 	t.Run("Sample Input", func(t *testing.T) {
-		output := SolveDay1Part1(sampleInput)
+		output, err := SolveDay1Part1(sampleInput)
+		if err != nil {
+			t.Fatalf("SolveDay1Part1() unexpected error %v", err)
+		}
 		if output != expectedSampleOutput {
 			t.Errorf("Expected %d, got %d", expectedSampleOutput, output)
 		}
@@ -78,9 +87,42 @@ func TestSolveDay1Part2(t *testing.T) {
 	const expectedPart2Output = 6
 
 	t.Run("Sample Input Part 2", func(t *testing.T) {
-		output := SolveDay1Part2(sampleInput)
+		output, err := SolveDay1Part2(sampleInput)
+		if err != nil {
+			t.Fatalf("SolveDay1Part2() unexpected error %v", err)
+		}
 		if output != expectedPart2Output {
 			t.Errorf("Expected %d, got %d", expectedPart2Output, output)
 		}
 	})
 }
+
+func TestSolveDay1Part1ReaderReportsBadLines(t *testing.T) {
+	tests := []struct {
+		input  string
+		line   int
+		column int
+	}{
+		{"L68\nX30\n", 2, 1},
+		{"L68\nL30\nR4x\n", 3, 2},
+		{"L\n", 1, 1},
+	}
+	for _, test := range tests {
+		_, err := SolveDay1Part1Reader(strings.NewReader(test.input))
+		var posErr *input.Error
+		if !errors.As(err, &posErr) || posErr.Line != test.line || posErr.Column != test.column {
+			t.Errorf("SolveDay1Part1Reader(%q) error = %v, expected line %d, column %d", test.input, err, test.line, test.column)
+		}
+	}
+	bad := "L68\nL30\nbogus\nR48\n"
+	if result, err := (Day1{}).Part1(context.Background(), bad); err == nil {
+		t.Errorf("Day1.Part1(%q) = %v, expected an error for a bad line", bad, result)
+	}
+	if result, err := (Day1{}).Part2(context.Background(), bad); err == nil {
+		t.Errorf("Day1.Part2(%q) = %v, expected an error for a bad line", bad, result)
+	}
+}
+
+func TestGolden(t *testing.T) {
+	golden.Run(t, Day1{})
+}
@@ -1,11 +1,35 @@
 package day10
 
+import (
+	"context"
+	"io"
+
+	"aoc2025/registry"
+	"aoc2025/solver"
+)
+
 type Day10 struct{}
 
-func (d Day10) Part1(input string) interface{} {
-	return SolveDay10Part1(input)
+func init() {
+	registry.Register(registry.Entry{Year: 2025, Day: 10, Title: "Factory", Solver: Day10{}})
+}
+
+func (d Day10) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay10Part1(input)
+	return solver.Int(answer), err
+}
+
+func (d Day10) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay10Part2(ctx, input)
+	return solver.Int(answer), err
+}
+
+func (d Day10) Part1Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	answer, err := SolveDay10Part1Reader(r)
+	return solver.Int(answer), err
 }
 
-func (d Day10) Part2(input string) (interface{}, bool) {
-	return SolveDay10Part2(input), true
+func (d Day10) Part2Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	answer, err := SolveDay10Part2Reader(ctx, r)
+	return solver.Int(answer), err
 }
@@ -1,12 +1,16 @@
 package day10
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"math"
 	"regexp"
 	"strconv"
 	"strings"
 
+	"aoc2025/input"
 	"gonum.org/v1/gonum/mat"
 )
 
@@ -41,7 +45,33 @@ func MakeSolvable(input string) (Solvable, error) {
 	return solvable, nil
 }
 
-func (s *Solvable) Solve() ([]int, bool) {
+// canceller lets the long searches check their context without paying for it
+// on every step, each goroutine needs its own
+type canceller struct {
+	ctx   context.Context
+	steps int
+	done  bool
+}
+
+func newCanceller(ctx context.Context) *canceller {
+	return &canceller{ctx: ctx}
+}
+
+// stopped reports whether the search should give up
+func (c *canceller) stopped() bool {
+	if c.done {
+		return true
+	}
+	c.steps++
+	if c.steps%1024 == 0 && c.ctx.Err() != nil {
+		c.done = true
+	}
+	return c.done
+}
+
+// Solve finds the solution with the fewest presses
+// the searches give up when ctx is cancelled, and Solve reports no solution
+func (s *Solvable) Solve(ctx context.Context) ([]int, bool) {
 	numIndicators := len(s.Workspace)
 	numButtons := len(s.Buttons)
 
@@ -101,7 +131,7 @@ func (s *Solvable) Solve() ([]int, bool) {
 			return intSol, true
 		}
 		if numButtons <= 5 {
-			return s.searchAroundSolution(intSol, 3)
+			return s.searchAroundSolution(ctx, intSol, 3)
 		}
 		for delta := 1; delta <= 2; delta++ {
 			for i := 0; i < numButtons; i++ {
@@ -118,7 +148,7 @@ func (s *Solvable) Solve() ([]int, bool) {
 			}
 		}
 		if numButtons <= 5 {
-			return s.bruteForceSearch(30)
+			return s.bruteForceSearch(ctx, 30)
 		}
 		return nil, false
 	}
@@ -132,7 +162,7 @@ func (s *Solvable) Solve() ([]int, bool) {
 		}
 	}
 
-	return s.searchNullSpace(particular, nullVecs, nullDim, numButtons)
+	return s.searchNullSpace(ctx, particular, nullVecs, nullDim, numButtons)
 }
 
 func (s *Solvable) computePseudoinverseSolution(uMat, vMat *mat.Dense, values []float64, b *mat.VecDense, tol float64, numButtons, numIndicators int) []float64 {
@@ -163,29 +193,33 @@ func (s *Solvable) computePseudoinverseSolution(uMat, vMat *mat.Dense, values []
 	return particular
 }
 
-func (s *Solvable) searchNullSpace(particular []float64, nullVecs [][]float64, nullDim, numButtons int) ([]int, bool) {
+func (s *Solvable) searchNullSpace(ctx context.Context, particular []float64, nullVecs [][]float64, nullDim, numButtons int) ([]int, bool) {
 	bestSum := math.Inf(1)
 	var bestSol []int
 
 	switch nullDim {
 	case 1:
-		bestSol, _ = s.search1DNullSpace(particular, nullVecs[0], numButtons, bestSum)
+		bestSol, _ = s.search1DNullSpace(ctx, particular, nullVecs[0], numButtons, bestSum)
 	case 2:
-		bestSol, _ = s.search2DNullSpace(particular, nullVecs, numButtons, bestSum)
+		bestSol, _ = s.search2DNullSpace(ctx, particular, nullVecs, numButtons, bestSum)
 	case 3:
-		bestSol, _ = s.search3DNullSpaceParallel(particular, nullVecs, numButtons, bestSum)
+		bestSol, _ = s.search3DNullSpaceParallel(ctx, particular, nullVecs, numButtons, bestSum)
 	default:
-		bestSol, _ = s.searchNDNullSpace(particular, nullVecs, nullDim, numButtons, bestSum)
+		bestSol, _ = s.searchNDNullSpace(ctx, particular, nullVecs, nullDim, numButtons, bestSum)
 	}
 
+	if ctx.Err() != nil {
+		return nil, false
+	}
 	if bestSol != nil {
 		return bestSol, true
 	}
 	return nil, false
 }
 
-func (s *Solvable) search1DNullSpace(particular []float64, nullVec []float64, numButtons int, bestSum float64) ([]int, float64) {
+func (s *Solvable) search1DNullSpace(ctx context.Context, particular []float64, nullVec []float64, numButtons int, bestSum float64) ([]int, float64) {
 	var bestSol []int
+	cancel := newCanceller(ctx)
 
 	minMult, maxMult := -500, 500
 	for j := 0; j < numButtons; j++ {
@@ -211,6 +245,9 @@ func (s *Solvable) search1DNullSpace(particular []float64, nullVec []float64, nu
 	}
 
 	for mult := minMult - 10; mult <= maxMult+10; mult++ {
+		if cancel.stopped() {
+			break
+		}
 		intSol, sum, ok := s.tryNullSpaceSolution(particular, nullVec, float64(mult), numButtons)
 		if ok && float64(sum) < bestSum {
 			bestSum = float64(sum)
@@ -221,12 +258,16 @@ func (s *Solvable) search1DNullSpace(particular []float64, nullVec []float64, nu
 	return bestSol, bestSum
 }
 
-func (s *Solvable) search2DNullSpace(particular []float64, nullVecs [][]float64, numButtons int, bestSum float64) ([]int, float64) {
+func (s *Solvable) search2DNullSpace(ctx context.Context, particular []float64, nullVecs [][]float64, numButtons int, bestSum float64) ([]int, float64) {
 	var bestSol []int
 	searchRange := 100
+	cancel := newCanceller(ctx)
 
 	for m1 := -searchRange; m1 <= searchRange; m1++ {
 		for m2 := -searchRange; m2 <= searchRange; m2++ {
+			if cancel.stopped() {
+				return bestSol, bestSum
+			}
 			sol := make([]float64, numButtons)
 			for i := 0; i < numButtons; i++ {
 				sol[i] = particular[i] + float64(m1)*nullVecs[0][i] + float64(m2)*nullVecs[1][i]
@@ -243,7 +284,8 @@ func (s *Solvable) search2DNullSpace(particular []float64, nullVecs [][]float64,
 	return bestSol, bestSum
 }
 
-func (s *Solvable) search3DNullSpaceParallel(particular []float64, nullVecs [][]float64, numButtons int, bestSum float64) ([]int, float64) {
+// every worker sends exactly one result, also when cancelled, so none are left behind
+func (s *Solvable) search3DNullSpaceParallel(ctx context.Context, particular []float64, nullVecs [][]float64, numButtons int, bestSum float64) ([]int, float64) {
 	var bestSol []int
 	searchRange := 100
 
@@ -270,10 +312,15 @@ func (s *Solvable) search3DNullSpaceParallel(particular []float64, nullVecs [][]
 		go func(start, end int) {
 			localBestSum := math.MaxInt
 			var localBestSol []int
+			cancel := newCanceller(ctx)
 
+		search:
 			for m1 := start; m1 < end; m1++ {
 				for m2 := -searchRange; m2 <= searchRange; m2++ {
 					for m3 := -searchRange; m3 <= searchRange; m3++ {
+						if cancel.stopped() {
+							break search
+						}
 						sol := make([]float64, numButtons)
 						for i := 0; i < numButtons; i++ {
 							sol[i] = particular[i] +
@@ -310,12 +357,16 @@ func (s *Solvable) search3DNullSpaceParallel(particular []float64, nullVecs [][]
 	return bestSol, bestSum
 }
 
-func (s *Solvable) searchNDNullSpace(particular []float64, nullVecs [][]float64, nullDim, numButtons int, bestSum float64) ([]int, float64) {
+func (s *Solvable) searchNDNullSpace(ctx context.Context, particular []float64, nullVecs [][]float64, nullDim, numButtons int, bestSum float64) ([]int, float64) {
 	var bestSol []int
 	searchRange := 30
+	cancel := newCanceller(ctx)
 
 	var search func(depth int, mult []int)
 	search = func(depth int, mult []int) {
+		if cancel.stopped() {
+			return
+		}
 		if depth == nullDim {
 			sol := make([]float64, numButtons)
 			for i := 0; i < numButtons; i++ {
@@ -387,14 +438,15 @@ func (s *Solvable) verifySolution(sol []int) bool {
 	return true
 }
 
-func (s *Solvable) bruteForceSearch(maxPresses int) ([]int, bool) {
+func (s *Solvable) bruteForceSearch(ctx context.Context, maxPresses int) ([]int, bool) {
 	numButtons := len(s.Buttons)
 	bestSum := math.MaxInt
 	var bestSol []int
+	cancel := newCanceller(ctx)
 
 	var search func(depth int, current []int, currentSum int, partial []int)
 	search = func(depth int, current []int, currentSum int, partial []int) {
-		if currentSum >= bestSum {
+		if currentSum >= bestSum || cancel.stopped() {
 			return
 		}
 		if depth == numButtons {
@@ -448,20 +500,21 @@ func (s *Solvable) bruteForceSearch(maxPresses int) ([]int, bool) {
 
 	search(0, make([]int, numButtons), 0, make([]int, len(s.Goal)))
 
-	if bestSol != nil {
+	if bestSol != nil && !cancel.done {
 		return bestSol, true
 	}
 	return nil, false
 }
 
-func (s *Solvable) searchAroundSolution(start []int, radius int) ([]int, bool) {
+func (s *Solvable) searchAroundSolution(ctx context.Context, start []int, radius int) ([]int, bool) {
 	numButtons := len(start)
 	bestSum := math.MaxInt
 	var bestSol []int
+	cancel := newCanceller(ctx)
 
 	var search func(depth int, current []int, currentSum int)
 	search = func(depth int, current []int, currentSum int) {
-		if currentSum >= bestSum {
+		if currentSum >= bestSum || cancel.stopped() {
 			return
 		}
 		if depth == numButtons {
@@ -487,7 +540,7 @@ func (s *Solvable) searchAroundSolution(start []int, radius int) ([]int, bool) {
 
 	search(0, make([]int, numButtons), 0)
 
-	if bestSol != nil {
+	if bestSol != nil && !cancel.done {
 		return bestSol, true
 	}
 	return nil, false
@@ -786,41 +839,59 @@ func parseJoltage(line string) (Joltage, error) {
 	return MakeJoltage(matches[1])
 }
 
-func SolveDay10Part1(input string) int {
-	machines := ReadInput(input)
+func SolveDay10Part1(input string) (int, error) {
+	return SolveDay10Part1Reader(strings.NewReader(input))
+}
+
+// SolveDay10Part1Reader is SolveDay10Part1 reading one machine at a time from r
+func SolveDay10Part1Reader(r io.Reader) (int, error) {
 	result := 0
-	for _, machine := range machines {
-		vector, success := machine.Solve()
-		if !success {
-			continue
-		}
-		for _, pressed := range vector {
-			if pressed {
-				result++
+	scanner := input.NewScanner(r, input.Strict)
+	for more := true; more; more = scanner.NextBlock() {
+		for scanner.Scan() {
+			machine, err := parseMachine(strings.TrimSpace(scanner.Text()))
+			if err != nil {
+				return 0, scanner.Reject(err)
+			}
+			vector, success := machine.Solve()
+			if !success {
+				return 0, scanner.Reject(errors.New("no buttons reach the indicator lights"))
+			}
+			for _, pressed := range vector {
+				if pressed {
+					result++
+				}
 			}
 		}
 	}
-	return result
+	return result, scanner.Err()
+}
+
+func SolveDay10Part2(ctx context.Context, input string) (int, error) {
+	return SolveDay10Part2Reader(ctx, strings.NewReader(input))
 }
 
-func SolveDay10Part2(input string) int {
+// SolveDay10Part2Reader is SolveDay10Part2 reading one machine at a time from r
+func SolveDay10Part2Reader(ctx context.Context, r io.Reader) (int, error) {
 	result := 0
-	for _, line := range strings.Split(input, "\n") {
-		line = strings.TrimSpace(line)
-		if line == "" {
-			continue
-		}
-		solvable, err := MakeSolvable(line)
-		if err != nil {
-			continue
-		}
-		vector, success := solvable.Solve()
-		if !success {
-			continue
-		}
-		for _, presses := range vector {
-			result += presses
+	scanner := input.NewScanner(r, input.Strict)
+	for more := true; more; more = scanner.NextBlock() {
+		for scanner.Scan() {
+			solvable, err := MakeSolvable(scanner.Text())
+			if err != nil {
+				return 0, scanner.Reject(err)
+			}
+			vector, success := solvable.Solve(ctx)
+			if err := ctx.Err(); err != nil {
+				return 0, err
+			}
+			if !success {
+				return 0, scanner.Reject(errors.New("no presses reach the joltage"))
+			}
+			for _, presses := range vector {
+				result += presses
+			}
 		}
 	}
-	return result
+	return result, scanner.Err()
 }
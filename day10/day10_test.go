@@ -1,8 +1,13 @@
 package day10
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
+
+	"aoc2025/golden"
+	"aoc2025/input"
 )
 
 func contains(slice []int, value int) bool {
@@ -324,19 +329,36 @@ func TestSolveIndividualMachines(t *testing.T) {
 }
 
 func TestSolveDay10Part1(t *testing.T) {
-	result := SolveDay10Part1(sampleInput)
+	result, err := SolveDay10Part1(sampleInput)
+	if err != nil {
+		t.Fatalf("SolveDay10Part1() unexpected error %v", err)
+	}
 	if result != sampleOutPutPart1 {
 		t.Errorf("Expected %d, got %d", sampleOutPutPart1, result)
 	}
 }
 
 func TestSolveDay10Part2(t *testing.T) {
-	result := SolveDay10Part2(sampleInput)
+	result, err := SolveDay10Part2(context.Background(), sampleInput)
+	if err != nil {
+		t.Fatalf("SolveDay10Part2() unexpected error %v", err)
+	}
 	if result != sampleOutPutPart2 {
 		t.Errorf("Expected %d, got %d", sampleOutPutPart2, result)
 	}
 }
 
+func TestSolveDay10InvalidInput(t *testing.T) {
+	text := "[.##.] (3) (1,3) (2) (2,3) (0,2) (0,1) {3,5,4,7}\n[.#] (0 {1}\n"
+	var posErr *input.Error
+	if _, err := SolveDay10Part1(text); !errors.As(err, &posErr) || posErr.Line != 2 {
+		t.Errorf("SolveDay10Part1() error = %v, expected one on line 2", err)
+	}
+	if _, err := SolveDay10Part2(context.Background(), text); !errors.As(err, &posErr) || posErr.Line != 2 {
+		t.Errorf("SolveDay10Part2() error = %v, expected one on line 2", err)
+	}
+}
+
 func TestSolvableSolve(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -374,7 +396,7 @@ func TestSolvableSolve(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			vector, success := tt.solvable.Solve()
+			vector, success := tt.solvable.Solve(context.Background())
 			if success != tt.shouldSucceed {
 				t.Errorf("%s: Expected success=%t, got %t", tt.description, tt.shouldSucceed, success)
 				return
@@ -399,3 +421,39 @@ func TestSolvableSolve(t *testing.T) {
 		})
 	}
 }
+
+func TestSolveDay10Part2Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := SolveDay10Part2(ctx, sampleInput)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("SolveDay10Part2() error = %v, expected %v", err, context.Canceled)
+	}
+}
+
+func TestSolvableSearchesStopWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	solvable := Solvable{
+		Workspace: []int{0, 0, 0},
+		Goal:      []int{20, 25, 30},
+		Buttons:   []Button{{0}, {1}, {2}, {0, 1}, {1, 2}},
+	}
+	if _, ok := solvable.bruteForceSearch(ctx, 30); ok {
+		t.Errorf("bruteForceSearch() found a solution after cancellation")
+	}
+	if _, ok := solvable.searchAroundSolution(ctx, []int{5, 5, 5, 5, 5}, 3); ok {
+		t.Errorf("searchAroundSolution() found a solution after cancellation")
+	}
+	particular := []float64{20, 25, 30, 0, 0}
+	nullVecs := [][]float64{{-1, -1, 0, 1, 0}, {0, -1, -1, 0, 1}, {0, 0, 0, 0, 0}, {0, 0, 0, 0, 0}}
+	for dim := 1; dim <= 4; dim++ {
+		if _, ok := solvable.searchNullSpace(ctx, particular, nullVecs[:dim], dim, 5); ok {
+			t.Errorf("searchNullSpace() with %d dimensions found a solution after cancellation", dim)
+		}
+	}
+}
+
+func TestGolden(t *testing.T) {
+	golden.Run(t, Day10{})
+}
@@ -2,26 +2,19 @@ package day2
 
 import (
 	"errors"
+	"io"
 	"strconv"
 	"strings"
+
+	"aoc2025/input"
+	"aoc2025/intervals"
 )
 
-type Range struct {
-	Start int
-	End   int
-}
+// Range is an inclusive range of ids, written as start-end
+type Range = intervals.Range
 
-func ToRange(s string) Range {
-	parts := strings.Split(s, "-")
-	start, err := strconv.Atoi(parts[0])
-	if err != nil {
-		panic(err)
-	}
-	end, err := strconv.Atoi(parts[1])
-	if err != nil {
-		panic(err)
-	}
-	return Range{Start: start, End: end}
+func ToRange(s string) (Range, error) {
+	return input.ParseRange(s)
 }
 
 // take number and return string if the number is only consisting
@@ -62,32 +55,38 @@ func Part2IdOfConcern(n int) (int, error) {
 }
 
 // take csv string and return string with all ids of concern
-func SolveDay2Part1(input string) int {
-	values := strings.Split(input, ",")
-	result := 0
-	for _, value := range values {
-		r := ToRange(value)
-		for i := r.Start; i <= r.End; i++ {
-			id, err := Part1IdOfConcern(i)
-			if err == nil {
-				result += id
-			}
-		}
-	}
-	return result
+func SolveDay2Part1(input string) (int, error) {
+	return SolveDay2Part1Reader(strings.NewReader(input))
 }
 
-func SolveDay2Part2(input string) int {
-	values := strings.Split(input, ",")
+// SolveDay2Part1Reader is SolveDay2Part1 reading one range at a time from r
+func SolveDay2Part1Reader(r io.Reader) (int, error) {
+	return sumIdsOfConcern(r, Part1IdOfConcern)
+}
+
+func SolveDay2Part2(input string) (int, error) {
+	return SolveDay2Part2Reader(strings.NewReader(input))
+}
+
+// SolveDay2Part2Reader is SolveDay2Part2 reading one range at a time from r
+func SolveDay2Part2Reader(r io.Reader) (int, error) {
+	return sumIdsOfConcern(r, Part2IdOfConcern)
+}
+
+// sumIdsOfConcern adds up the ids in every comma separated range that isOfConcern accepts
+// each range is summed on its own, so an id in two ranges counts twice
+func sumIdsOfConcern(r io.Reader, isOfConcern func(int) (int, error)) (int, error) {
 	result := 0
-	for _, value := range values {
-		r := ToRange(value)
-		for i := r.Start; i <= r.End; i++ {
-			id, err := Part2IdOfConcern(i)
+	err := input.Each(input.NewRecordScanner(r, ',', input.Strict), ToRange, func(rng Range) {
+		for i := rng.Start; i <= rng.End; i++ {
+			id, err := isOfConcern(i)
 			if err == nil {
 				result += id
 			}
 		}
+	})
+	if err != nil {
+		return 0, err
 	}
-	return result
+	return result, nil
 }
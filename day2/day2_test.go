@@ -1,6 +1,13 @@
 package day2
 
-import "testing"
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"aoc2025/golden"
+	"aoc2025/input"
+)
 
 const kDay2SampleInput = `11-22,95-115,998-1012,1188511880-1188511890,222220-222224,1698522-1698528,446443-446449,38593856-38593862,565653-565659,824824821-824824827,2121212118-2121212124`
 const kDay2SampleOutput = 1227775554
@@ -18,13 +25,36 @@ func TestToRange(t *testing.T) {
 		{"1698522-1698528", Range{Start: 1698522, End: 1698528}},
 	}
 	for _, test := range tests {
-		result := ToRange(test.input)
+		result, err := ToRange(test.input)
+		if err != nil {
+			t.Errorf("ToRange(%s) unexpected error %v", test.input, err)
+		}
 		if result != test.expected {
 			t.Errorf("ToRange(%s) = %v, expected %v", test.input, result, test.expected)
 		}
 	}
 }
 
+func TestToRangeInvalid(t *testing.T) {
+	for _, input := range []string{"", "11", "11-", "a-22", "1-2-3"} {
+		if _, err := ToRange(input); err == nil {
+			t.Errorf("ToRange(%q) expected error, got nil", input)
+		}
+	}
+}
+
+func TestSolveDay2Part1ReaderReportsBadRange(t *testing.T) {
+	_, err := SolveDay2Part1Reader(strings.NewReader("11-22,95-115,\n9x8-1012\n"))
+	var posErr *input.Error
+	if !errors.As(err, &posErr) || posErr.Line != 2 || posErr.Column != 1 {
+		t.Errorf("SolveDay2Part1Reader() error = %v, expected line 2, column 1", err)
+	}
+	_, err = SolveDay2Part1Reader(strings.NewReader("11-22,95-1x5"))
+	if !errors.As(err, &posErr) || posErr.Line != 1 || posErr.Column != 10 {
+		t.Errorf("SolveDay2Part1Reader() error = %v, expected line 1, column 10", err)
+	}
+}
+
 func TestPart1IdOfConcern(t *testing.T) {
 	tests := []struct {
 		input       int
@@ -86,8 +116,23 @@ func TestPart2IdOfConcern(t *testing.T) {
 }
 
 func TestSolveDay2Part1(t *testing.T) {
-	result := SolveDay2Part1(kDay2SampleInput)
+	result, err := SolveDay2Part1(kDay2SampleInput)
+	if err != nil {
+		t.Fatalf("SolveDay2Part1(%s) unexpected error %v", kDay2SampleInput, err)
+	}
 	if result != kDay2SampleOutput {
 		t.Errorf("SolveDay2Part1(%s) = %d, expected %d", kDay2SampleInput, result, kDay2SampleOutput)
 	}
 }
+
+func TestSolveDay2Part1OverlappingRanges(t *testing.T) {
+	// 11 and 22 are in both ranges, each range is summed on its own
+	result, err := SolveDay2Part1("11-22,11-22")
+	if err != nil || result != 66 {
+		t.Errorf("SolveDay2Part1(11-22,11-22) = %d, %v, expected 66", result, err)
+	}
+}
+
+func TestGolden(t *testing.T) {
+	golden.Run(t, Day2{})
+}
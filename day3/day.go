@@ -1,11 +1,35 @@
 package day3
 
+import (
+	"context"
+	"io"
+
+	"aoc2025/registry"
+	"aoc2025/solver"
+)
+
 type Day3 struct{}
 
-func (d Day3) Part1(input string) interface{} {
-	return SolveDay3Part1(input)
+func init() {
+	registry.Register(registry.Entry{Year: 2025, Day: 3, Title: "Lobby", Solver: Day3{}})
+}
+
+func (d Day3) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay3Part1(input)
+	return solver.Int(answer), err
+}
+
+func (d Day3) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay3Part2(input)
+	return solver.Int(answer), err
+}
+
+func (d Day3) Part1Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	answer, err := SolveDay3Part1Reader(r)
+	return solver.Int(answer), err
 }
 
-func (d Day3) Part2(input string) (interface{}, bool) {
-	return SolveDay3Part2(input), true
+func (d Day3) Part2Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	answer, err := SolveDay3Part2Reader(r)
+	return solver.Int(answer), err
 }
@@ -2,8 +2,11 @@ package day3
 
 import (
 	"errors"
+	"io"
 	"regexp"
 	"strings"
+
+	"aoc2025/input"
 )
 
 // each line is a string of digits. find the largest number made of a pair of digits, in order
@@ -103,38 +106,35 @@ func (b Bank) maxNHelper(n int, startIdx int) int {
 	return maxDigit*multiplier + remaining
 }
 
-func SolveDay3Part1(input string) interface{} {
-	result := 0
-	lines := strings.Split(input, "\n")
-	for _, line := range lines {
-		// Trim whitespace including carriage returns
-		line = strings.TrimSpace(line)
-		if line == "" {
-			continue
-		}
-		bank, err := Bank(nil).MakeBank(line)
-		if err != nil {
-			continue
-		}
-		result += bank.MaxPair()
-	}
-	return result
+func SolveDay3Part1(input string) (int, error) {
+	return SolveDay3Part1Reader(strings.NewReader(input))
+}
+
+// SolveDay3Part1Reader is SolveDay3Part1 reading one bank at a time from r
+func SolveDay3Part1Reader(r io.Reader) (int, error) {
+	return sumBanks(r, Bank.MaxPair)
+}
+
+func SolveDay3Part2(input string) (int, error) {
+	return SolveDay3Part2Reader(strings.NewReader(input))
+}
+
+// SolveDay3Part2Reader is SolveDay3Part2 reading one bank at a time from r
+func SolveDay3Part2Reader(r io.Reader) (int, error) {
+	return sumBanks(r, func(b Bank) int { return b.Max_N(12) })
 }
 
-func SolveDay3Part2(input string) interface{} {
+// sumBanks adds up joltage for every bank, one per line
+// an invalid line stops the sum with an error giving its line
+func sumBanks(r io.Reader, joltage func(Bank) int) (int, error) {
 	result := 0
-	lines := strings.Split(input, "\n")
-	for _, line := range lines {
-		// Trim whitespace including carriage returns
-		line = strings.TrimSpace(line)
-		if line == "" {
-			continue
-		}
-		bank, err := Bank(nil).MakeBank(line)
-		if err != nil {
-			continue
-		}
-		result += bank.Max_N(12)
-	}
-	return result
+	err := input.Each(input.NewScanner(r, input.Strict), parseBank, func(bank Bank) {
+		result += joltage(bank)
+	})
+	return result, err
+}
+
+// parseBank reads a bank from a line, ignoring whitespace around it
+func parseBank(line string) (Bank, error) {
+	return Bank(nil).MakeBank(strings.TrimSpace(line))
 }
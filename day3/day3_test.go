@@ -1,6 +1,12 @@
 package day3
 
-import "testing"
+import (
+	"errors"
+	"testing"
+
+	"aoc2025/golden"
+	"aoc2025/input"
+)
 
 const kDay3SampleInput = `987654321111111
 811111111111119
@@ -60,7 +66,10 @@ func TestMaxN(t *testing.T) {
 
 func TestSolveDay3Part1(t *testing.T) {
 	expected := 357
-	result := SolveDay3Part1(kDay3SampleInput)
+	result, err := SolveDay3Part1(kDay3SampleInput)
+	if err != nil {
+		t.Fatalf("SolveDay3Part1() unexpected error %v", err)
+	}
 	if result != expected {
 		t.Errorf("SolveDay3Part1() = %v; want %v", result, expected)
 	}
@@ -68,8 +77,25 @@ func TestSolveDay3Part1(t *testing.T) {
 
 func TestSolveDay3Part2(t *testing.T) {
 	expected := 3121910778619
-	result := SolveDay3Part2(kDay3SampleInput)
+	result, err := SolveDay3Part2(kDay3SampleInput)
+	if err != nil {
+		t.Fatalf("SolveDay3Part2() unexpected error %v", err)
+	}
 	if result != expected {
 		t.Errorf("SolveDay3Part2() = %v; want %v", result, expected)
 	}
 }
+
+func TestSolveDay3InvalidInput(t *testing.T) {
+	var posErr *input.Error
+	if _, err := SolveDay3Part1("987\n12x4\n"); !errors.As(err, &posErr) || posErr.Line != 2 {
+		t.Errorf("SolveDay3Part1() error = %v, expected one on line 2", err)
+	}
+	if _, err := SolveDay3Part2("987\n\n12x4\n"); !errors.As(err, &posErr) || posErr.Line != 3 {
+		t.Errorf("SolveDay3Part2() error = %v, expected one on line 3", err)
+	}
+}
+
+func TestGolden(t *testing.T) {
+	golden.Run(t, Day3{})
+}
@@ -1,14 +1,27 @@
 package day4
 
-// Day4 implements the Day interface for day 4
+import (
+	"context"
+
+	"aoc2025/registry"
+	"aoc2025/solver"
+)
+
+// Day4 implements the Solver interface for day 4
 type Day4 struct{}
 
-// Part1 implements the Day interface
-func (d Day4) Part1(input string) interface{} {
-	return SolveDay4Part1(input)
+func init() {
+	registry.Register(registry.Entry{Year: 2025, Day: 4, Title: "Printing Department", Solver: Day4{}})
+}
+
+// Part1 implements the Solver interface
+func (d Day4) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay4Part1(input)
+	return solver.Int(answer), err
 }
 
-// Part2 implements the Day interface
-func (d Day4) Part2(input string) (interface{}, bool) {
-	return SolveDay4Part2(input), true
+// Part2 implements the Solver interface
+func (d Day4) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay4Part2(input)
+	return solver.Int(answer), err
 }
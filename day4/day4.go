@@ -1,100 +1,93 @@
 package day4
 
-import "strings"
+import (
+	"strings"
+
+	"aoc2025/grid"
+)
 
 type Roll bool // location on the grid, true has roll, false is empty
 
-type Grid [][]Roll // 2D grid of rolls
+// Grid is a 2D grid of rolls, rows shorter than the longest are padded with empty locations
+type Grid struct {
+	grid.Grid[Roll]
+}
 
 type Callback func(x, y int) // callback function for grid operations
 
 // construct a Grid from input strings, taking list of strings and which rune is a roll
 func MakeGrid(input []string, mark rune) Grid {
-	grid := make(Grid, len(input))
+	rows := make([][]Roll, len(input))
 	for i, line := range input {
-		grid[i] = make([]Roll, len(line))
-		for j, char := range line {
-			grid[i][j] = Roll(char == mark)
+		rows[i] = make([]Roll, 0, len(line))
+		for _, char := range line {
+			rows[i] = append(rows[i], Roll(char == mark))
 		}
 	}
-	return grid
+	return Grid{grid.FromRows(rows, Roll(false))}
 }
 
 func (g Grid) CountAdjacent(x, y int) int {
 	// counts number of adjacent rolls (true) around position (x, y)
 	// where x is column (0 = leftmost) and y is row (0 = topmost)
 	// returns 0 if position is out of bounds
-	if y < 0 || y >= len(g) || x < 0 || x >= len(g[0]) {
+	p := grid.Point{X: x, Y: y}
+	if !g.In(p) {
 		return 0
 	}
 	count := 0
-	directions := [8][2]int{
-		{-1, -1}, {-1, 0}, {-1, 1},
-		{0, -1}, {0, 1},
-		{1, -1}, {1, 0}, {1, 1},
-	}
-	for _, dir := range directions {
-		newX, newY := x+dir[0], y+dir[1]
-		// check bounds
-		if newY >= 0 && newY < len(g) && newX >= 0 && newX < len(g[0]) {
-			if g[newY][newX] {
-				count++
-			}
+	for _, roll := range g.Neighbours8(p) {
+		if roll {
+			count++
 		}
 	}
 	return count
 }
 
-func (g Grid) pop(x, y int) {
-	// sets position (x, y) to false
-	if y >= 0 && y < len(g) && x >= 0 && x < len(g[0]) {
-		g[y][x] = false
-	}
-}
-
 func (g Grid) popMany(coordinates [][2]int) {
 	//takes a list of coordinates and sets those positions to false
 	for _, coord := range coordinates {
-		x, y := coord[0], coord[1]
-		g[y][x] = false
+		g.Set(grid.Point{X: coord[0], Y: coord[1]}, false)
 	}
 }
 
 // take a function and call it when a roll has less than 4 adjacent rolls
 func (g Grid) ApplyWhenSparse(callback Callback) {
-	for y := 0; y < len(g); y++ {
-		for x := 0; x < len(g[0]); x++ {
-			if g[y][x] && g.CountAdjacent(x, y) < 4 {
-				callback(x, y)
-			}
+	for p, roll := range g.All() {
+		if roll && g.CountAdjacent(p.X, p.Y) < 4 {
+			callback(p.X, p.Y)
 		}
 	}
 }
 
-func ParseGrid(input string, mark rune) Grid {
-	// parse input into grid
-	lines := []string{}
-	for _, line := range strings.Split(input, "\n") {
-		line = strings.TrimSpace(line)
-		lines = append(lines, line)
-	}
-	return MakeGrid(lines, mark)
+func ParseGrid(input string, mark rune) (Grid, error) {
+	// parse input into grid, blank lines are skipped
+	g, err := grid.Parse(strings.NewReader(input), ' ', func(char rune) Roll {
+		return Roll(char == mark)
+	})
+	return Grid{g}, err
 }
 
-func SolveDay4Part1(input string) int {
-	grid := ParseGrid(input, '@')
+func SolveDay4Part1(input string) (int, error) {
+	grid, err := ParseGrid(input, '@')
+	if err != nil {
+		return 0, err
+	}
 	count := 0
 	grid.ApplyWhenSparse(func(x, y int) {
 		count++
 	})
-	return count
+	return count, nil
 }
 
 // apply the sparse rule, counting and adding the rolls to a list
 // pop the counted rolls, then repeat until no more rolls can be removed
 // and count the total number of rolls removed
-func SolveDay4Part2(input string) int {
-	grid := ParseGrid(input, '@')
+func SolveDay4Part2(input string) (int, error) {
+	grid, err := ParseGrid(input, '@')
+	if err != nil {
+		return 0, err
+	}
 	count := 0
 	changed := true
 	for changed {
@@ -107,5 +100,5 @@ func SolveDay4Part2(input string) int {
 		})
 		grid.popMany(toPop)
 	}
-	return count
+	return count, nil
 }
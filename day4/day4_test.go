@@ -3,6 +3,9 @@ package day4
 import (
 	"reflect"
 	"testing"
+
+	"aoc2025/golden"
+	"aoc2025/grid"
 )
 
 const kDay4SampleInput = `..@@.@@@@.
@@ -26,15 +29,15 @@ func TestMakeGrid(t *testing.T) {
 		"....",
 		"@.@.",
 	}
-	grid := MakeGrid(input, '@')
-	expected := Grid{
+	result := MakeGrid(input, '@')
+	expected := Grid{grid.FromRows([][]Roll{
 		{true, false, false, true},
 		{false, true, true, false},
 		{false, false, false, false},
 		{true, false, true, false},
-	}
-	if !reflect.DeepEqual(grid, expected) {
-		t.Errorf("MakeGrid() = %v; want %v", grid, expected)
+	}, false)}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("MakeGrid() = %v; want %v", result, expected)
 	}
 }
 
@@ -62,10 +65,34 @@ func TestCountAdjacent(t *testing.T) {
 	}
 }
 
+func TestCountAdjacentRagged(t *testing.T) {
+	// rows shorter than the first used to be read past their end
+	grid, err := ParseGrid("@@@\n@\n@@@@", '@')
+	if err != nil {
+		t.Fatalf("ParseGrid() unexpected error %v", err)
+	}
+	tests := []struct {
+		x, y     int
+		expected int
+	}{
+		{1, 1, 7},
+		{3, 2, 1},
+		{3, 0, 1},
+	}
+	for _, test := range tests {
+		if got := grid.CountAdjacent(test.x, test.y); got != test.expected {
+			t.Errorf("CountAdjacent(%d, %d) = %d; want %d", test.x, test.y, got, test.expected)
+		}
+	}
+}
+
 func TestSolveDay4Part1(t *testing.T) {
 	input := kDay4SampleInput
 	expected := kDay4Part1Expected
-	result := SolveDay4Part1(input)
+	result, err := SolveDay4Part1(input)
+	if err != nil {
+		t.Fatalf("SolveDay4Part1() unexpected error %v", err)
+	}
 	if result != expected {
 		t.Errorf("SolveDay4Part1() = %v; want %v", result, expected)
 	}
@@ -74,8 +101,15 @@ func TestSolveDay4Part1(t *testing.T) {
 func TestSolveDay4Part2(t *testing.T) {
 	input := kDay4SampleInput
 	expected := kDay4Part2Expected
-	result := SolveDay4Part2(input)
+	result, err := SolveDay4Part2(input)
+	if err != nil {
+		t.Fatalf("SolveDay4Part2() unexpected error %v", err)
+	}
 	if result != expected {
 		t.Errorf("SolveDay4Part2() = %v; want %v", result, expected)
 	}
 }
+
+func TestGolden(t *testing.T) {
+	golden.Run(t, Day4{})
+}
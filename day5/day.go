@@ -1,14 +1,40 @@
 package day5
 
-// Day5 implements the Day interface for day 5
+import (
+	"context"
+	"io"
+
+	"aoc2025/registry"
+	"aoc2025/solver"
+)
+
+// Day5 implements the Solver interface for day 5
 type Day5 struct{}
 
-// Part1 implements the Day interface
-func (d Day5) Part1(input string) interface{} {
-	return SolveDay5Part1(input)
+func init() {
+	registry.Register(registry.Entry{Year: 2025, Day: 5, Title: "Cafeteria", Solver: Day5{}})
+}
+
+// Part1 implements the Solver interface
+func (d Day5) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay5Part1(input)
+	return solver.Int(answer), err
+}
+
+// Part2 implements the Solver interface
+func (d Day5) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay5Part2(input)
+	return solver.Int(answer), err
+}
+
+// Part1Reader implements the Streamer interface
+func (d Day5) Part1Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	answer, err := SolveDay5Part1Reader(r)
+	return solver.Int(answer), err
 }
 
-// Part2 implements the Day interface
-func (d Day5) Part2(input string) (interface{}, bool) {
-	return SolveDay5Part2(input), true
+// Part2Reader implements the Streamer interface
+func (d Day5) Part2Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	answer, err := SolveDay5Part2Reader(r)
+	return solver.Int(answer), err
 }
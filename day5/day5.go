@@ -1,134 +1,95 @@
 package day5
 
 import (
-	"sort"
-	"strconv"
+	"io"
 	"strings"
-)
-
-type IdRange struct {
-	Start int
-	End   int
-}
 
-func ToIdRange(s string) IdRange {
-	parts := strings.Split(s, "-")
-	start, err := strconv.Atoi(parts[0])
-	if err != nil {
-		panic(err)
-	}
-	end, err := strconv.Atoi(parts[1])
-	if err != nil {
-		panic(err)
-	}
-	return IdRange{Start: start, End: end}
-}
+	"aoc2025/input"
+	"aoc2025/intervals"
+)
 
-func IsIdInRange(id int, r IdRange) bool {
-	return id >= r.Start && id <= r.End
-}
+// IdRange is an inclusive range of fresh ids
+type IdRange = intervals.Range
 
-func WhenIdIsInRanges(id int, ranges []IdRange, f func(int)) {
-	for _, r := range ranges {
-		if IsIdInRange(id, r) {
-			f(id)
-		}
-	}
+func ToIdRange(s string) (IdRange, error) {
+	return input.ParseRange(s)
 }
 
 // take a list of ranges and give back a list of non-overlapping ranges,
-// each with start and end encompassing all overlapping ranges
+// each with start and end encompassing all overlapping or touching ranges
 func FlattenRanges(ranges []IdRange) []IdRange {
-	if len(ranges) == 0 {
-		return ranges
-	}
-
-	// sort ranges by start value
-	sortedRanges := make([]IdRange, len(ranges))
-	copy(sortedRanges, ranges)
-	sort.Slice(sortedRanges, func(i, j int) bool {
-		return sortedRanges[i].Start < sortedRanges[j].Start
-	})
-
-	// merge overlapping ranges
-	newRanges := make([]IdRange, 0)
-	newRanges = append(newRanges, sortedRanges[0])
-
-	for i := 1; i < len(sortedRanges); i++ {
-		lastRange := &newRanges[len(newRanges)-1]
-		currentRange := sortedRanges[i]
-
-		// check if current range overlaps with the last merged range
-		if currentRange.Start <= lastRange.End {
-			// do the merge
-			if currentRange.End > lastRange.End {
-				lastRange.End = currentRange.End
-			}
-		} else {
-			// add the current range as a new range
-			newRanges = append(newRanges, currentRange)
-		}
-	}
-
-	return newRanges
+	return intervals.Of(ranges...).Ranges()
 }
 
+// CountTotalRangeSpan counts the ids in any of ranges, once each
 func CountTotalRangeSpan(ranges []IdRange) int {
-	total := 0
-	for _, r := range ranges {
-		total += r.End - r.Start + 1
-	}
-	return total
+	return intervals.Of(ranges...).Span()
 }
 
 // input is first ranges, blank line, then ids, all newline separated
-func ReadInput(input string) ([]IdRange, []int) {
-	lines := strings.Split(input, "\n")
-	ranges := make([]IdRange, 0)
-	ids := make([]int, 0)
-	start_id_index := 0
-
-	// read up until the first blank line, putting the ranges in the ranges array
-	for i := 0; i < len(lines); i++ {
-		if lines[i] == "" {
-			start_id_index = i + 1
-			break
-		}
-		ranges = append(ranges, ToIdRange(lines[i]))
+func ReadInput(text string) ([]IdRange, []int, error) {
+	scanner := input.NewScanner(strings.NewReader(text), input.Strict)
+	ranges, err := readRanges(scanner)
+	if err != nil {
+		return nil, nil, err
 	}
-	// read the ids
-	for i := start_id_index; i < len(lines); i++ {
-		id, err := strconv.Atoi(lines[i])
-		if err != nil {
-			break // stop reading ids if we can't convert the line to an int
-		}
+	ids := make([]int, 0)
+	err = readIds(scanner, func(id int) {
 		ids = append(ids, id)
+	})
+	return ranges, ids, err
+}
+
+// readRanges reads the first block, returning its ranges
+func readRanges(scanner *input.Scanner) ([]IdRange, error) {
+	ranges := make([]IdRange, 0)
+	err := input.Block(scanner, ToIdRange, func(r IdRange) {
+		ranges = append(ranges, r)
+	})
+	if err != nil {
+		return nil, err
 	}
-	return ranges, ids
+	return ranges, nil
 }
 
-func IsIdInAnyRange(id int, ranges []IdRange) bool {
-	for _, r := range ranges {
-		if IsIdInRange(id, r) {
-			return true
-		}
+// readIds hands every id of the block after the ranges to f, one at a time
+func readIds(scanner *input.Scanner, f func(int)) error {
+	if !scanner.NextBlock() {
+		return scanner.Err()
 	}
-	return false
+	return input.Block(scanner, input.Int, f)
+}
+
+func SolveDay5Part1(input string) (int, error) {
+	return SolveDay5Part1Reader(strings.NewReader(input))
 }
 
-func SolveDay5Part1(input string) int {
-	ranges, ids := ReadInput(input)
+// SolveDay5Part1Reader is SolveDay5Part1 checking one id at a time as it is read from r
+func SolveDay5Part1Reader(r io.Reader) (int, error) {
+	scanner := input.NewScanner(r, input.Strict)
+	ranges, err := readRanges(scanner)
+	if err != nil {
+		return 0, err
+	}
+	fresh := intervals.Of(ranges...)
 	result := 0
-	for _, id := range ids {
-		if IsIdInAnyRange(id, ranges) {
+	err = readIds(scanner, func(id int) {
+		if fresh.Contains(id) {
 			result++
 		}
-	}
-	return result
+	})
+	return result, err
+}
+
+func SolveDay5Part2(input string) (int, error) {
+	return SolveDay5Part2Reader(strings.NewReader(input))
 }
 
-func SolveDay5Part2(input string) int {
-	ranges, _ := ReadInput(input)               // we only care about the ranges
-	flattenedRanges := FlattenRanges(ranges)    // we only want the total span of the ranges
-	return CountTotalRangeSpan(flattenedRanges) // answer is the total number included in the ranges
+// SolveDay5Part2Reader is SolveDay5Part2 reading r only up to the end of the ranges
+func SolveDay5Part2Reader(r io.Reader) (int, error) {
+	ranges, err := readRanges(input.NewScanner(r, input.Strict)) // we only care about the ranges
+	if err != nil {
+		return 0, err
+	}
+	return CountTotalRangeSpan(ranges), nil // answer is the total number included in the ranges
 }
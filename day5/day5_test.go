@@ -1,6 +1,12 @@
 package day5
 
-import "testing"
+import (
+	"errors"
+	"testing"
+
+	"aoc2025/golden"
+	"aoc2025/input"
+)
 
 const kDay5SampleInput = `3-5
 10-14
@@ -18,7 +24,10 @@ const kDay5SampleOutputPart1 = 3
 const kDay5SampleOutputPart2 = 14
 
 func TestReadInput(t *testing.T) {
-	ranges, ids := ReadInput(kDay5SampleInput)
+	ranges, ids, err := ReadInput(kDay5SampleInput)
+	if err != nil {
+		t.Fatalf("ReadInput() unexpected error %v", err)
+	}
 	if len(ranges) != 4 {
 		t.Errorf("Expected 4 ranges, got %d", len(ranges))
 	}
@@ -27,6 +36,32 @@ func TestReadInput(t *testing.T) {
 	}
 }
 
+func TestReadInputInvalidRange(t *testing.T) {
+	_, _, err := ReadInput("3-5\n10\n\n1")
+	if err == nil {
+		t.Errorf("ReadInput() expected error for malformed range, got nil")
+	}
+}
+
+func TestReadInputReportsPosition(t *testing.T) {
+	tests := []struct {
+		input  string
+		line   int
+		column int
+	}{
+		{"3-5\n10\n\n1", 2, 1},
+		{"3-5\n10-x\n\n1", 2, 4},
+		{"3-5\n\n1\n2\nfive\n", 5, 1},
+	}
+	for _, test := range tests {
+		_, _, err := ReadInput(test.input)
+		var posErr *input.Error
+		if !errors.As(err, &posErr) || posErr.Line != test.line || posErr.Column != test.column {
+			t.Errorf("ReadInput(%q) error = %v, expected line %d, column %d", test.input, err, test.line, test.column)
+		}
+	}
+}
+
 func TestFlattenRanges(t *testing.T) {
 	ranges := []IdRange{
 		{Start: 3, End: 5},
@@ -49,16 +84,43 @@ func TestFlattenRanges(t *testing.T) {
 	}
 }
 
+func TestCountTotalRangeSpan(t *testing.T) {
+	tests := []struct {
+		ranges   []IdRange
+		expected int
+	}{
+		{[]IdRange{{Start: 3, End: 5}, {Start: 10, End: 20}}, 14},
+		{[]IdRange{{Start: 3, End: 5}, {Start: 4, End: 8}}, 6},
+		{[]IdRange{{Start: 3, End: 5}, {Start: 3, End: 5}}, 3},
+		{nil, 0},
+	}
+	for _, test := range tests {
+		if result := CountTotalRangeSpan(test.ranges); result != test.expected {
+			t.Errorf("CountTotalRangeSpan(%v) = %d, expected %d", test.ranges, result, test.expected)
+		}
+	}
+}
+
 func TestSolveDay5Part1(t *testing.T) {
-	result := SolveDay5Part1(kDay5SampleInput)
+	result, err := SolveDay5Part1(kDay5SampleInput)
+	if err != nil {
+		t.Fatalf("SolveDay5Part1() unexpected error %v", err)
+	}
 	if result != kDay5SampleOutputPart1 {
 		t.Errorf("SolveDay5Part1(%s) = %d, expected %d", kDay5SampleInput, result, kDay5SampleOutputPart1)
 	}
 }
 
 func TestSolveDay5Part2(t *testing.T) {
-	result := SolveDay5Part2(kDay5SampleInput)
+	result, err := SolveDay5Part2(kDay5SampleInput)
+	if err != nil {
+		t.Fatalf("SolveDay5Part2() unexpected error %v", err)
+	}
 	if result != kDay5SampleOutputPart2 {
 		t.Errorf("SolveDay5Part2(%s) = %d, expected %d", kDay5SampleInput, result, kDay5SampleOutputPart2)
 	}
 }
+
+func TestGolden(t *testing.T) {
+	golden.Run(t, Day5{})
+}
@@ -1,11 +1,24 @@
 package day6
 
+import (
+	"context"
+
+	"aoc2025/registry"
+	"aoc2025/solver"
+)
+
 type Day6 struct{}
 
-func (d Day6) Part1(input string) interface{} {
-	return SolveDay6Part1(input)
+func init() {
+	registry.Register(registry.Entry{Year: 2025, Day: 6, Title: "Trash Compactor", Solver: Day6{}})
+}
+
+func (d Day6) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay6Part1(input)
+	return solver.Int(answer), err
 }
 
-func (d Day6) Part2(input string) (interface{}, bool) {
-	return SolveDay6Part2(input), true
+func (d Day6) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay6Part2(input)
+	return solver.Int(answer), err
 }
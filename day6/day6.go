@@ -1,6 +1,12 @@
 package day6
 
-import "strings"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"aoc2025/grid"
+)
 
 type mathProblem struct {
 	operands []int
@@ -8,15 +14,16 @@ type mathProblem struct {
 }
 
 // make a math problem from a 2D grid of runes
-func makeMathProblem(grid [][]rune) mathProblem {
+func makeMathProblem(problem grid.Grid[rune]) (mathProblem, error) {
 	operands := make([]int, 0)
 	operator := ' '
-	for _, row := range grid {
+	for y := 0; y < problem.Height(); y++ {
+		row := problem.Row(y)
 		hasOperator := false
 		for _, cell := range row {
 			if cell == '+' || cell == '*' {
 				if operator != ' ' {
-					panic("multiple operators in a row")
+					return mathProblem{}, errors.New("multiple operators in a row")
 				}
 				operator = cell
 				hasOperator = true
@@ -33,7 +40,7 @@ func makeMathProblem(grid [][]rune) mathProblem {
 				currentNumber = currentNumber*10 + int(cell-'0')
 				hasDigits = true
 			} else if cell != ' ' {
-				panic("invalid character in problem")
+				return mathProblem{}, errors.New("invalid character in problem")
 			}
 		}
 		if hasDigits {
@@ -41,59 +48,50 @@ func makeMathProblem(grid [][]rune) mathProblem {
 		}
 	}
 	if operator == ' ' {
-		panic("no operator in problem")
+		return mathProblem{}, errors.New("no operator in problem")
 	}
 	if len(operands) < 2 {
-		panic("invalid number of operands in problem")
+		return mathProblem{}, errors.New("invalid number of operands in problem")
 	}
 	return mathProblem{
 		operands: operands,
 		operator: operator,
-	}
+	}, nil
 }
 
 // read the grid right-to-left columnar, so
 //
-// 123
-//  45
-//   6
-// *
+//	123
+//	 45
+//	  6
+//	*
 //
 // should read as 356 * 24 * 1
-func makeMathProblemColumnar(grid [][]rune) mathProblem {
-	if len(grid) == 0 {
-		panic("empty grid")
-	}
-	maxWidth := 0
-	for _, row := range grid {
-		if len(row) > maxWidth {
-			maxWidth = len(row)
-		}
+func makeMathProblemColumnar(problem grid.Grid[rune]) (mathProblem, error) {
+	if problem.Height() == 0 {
+		return mathProblem{}, errors.New("empty grid")
 	}
+	// turned counter clockwise, the columns from right to left become rows read top to bottom
+	columns := problem.RotateCounterClockwise()
 
 	operands := make([]int, 0)
 	operator := ' '
-	// iterate columns from right to left
-	for col := maxWidth - 1; col >= 0; col-- {
+	for col := 0; col < columns.Height(); col++ {
 		// collect digits from top to bottom in this column
 		digits := make([]int, 0)
 		foundOperator := false
-		for row := 0; row < len(grid); row++ {
-			if col >= len(grid[row]) {
-				continue
-			}
-			cell := grid[row][col]
+		for _, cell := range columns.Row(col) {
 			if cell >= '0' && cell <= '9' {
 				digits = append(digits, int(cell-'0'))
 			} else if cell == '+' || cell == '*' {
 				if operator != ' ' {
-					panic("multiple operators in a column")
+					return mathProblem{}, errors.New("multiple operators in a column")
 				}
 				operator = cell
 				foundOperator = true
 				break
 			} else if cell != ' ' {
-				panic("invalid character in problem")
+				return mathProblem{}, errors.New("invalid character in problem")
 			}
 		}
 		if len(digits) > 0 {
@@ -108,189 +106,132 @@ func makeMathProblemColumnar(grid [][]rune) mathProblem {
 		}
 	}
 	if operator == ' ' {
-		panic("no operator in problem")
+		return mathProblem{}, errors.New("no operator in problem")
 	}
 	if len(operands) < 2 {
-		panic("invalid number of operands in problem")
+		return mathProblem{}, errors.New("invalid number of operands in problem")
 	}
 	return mathProblem{
 		operands: operands,
 		operator: operator,
-	}
+	}, nil
 }
 
 // solve a math problem, return the result
 // this assumes that the problem is valid, and that the operands are valid,
 // since we take in some type that we assume use a constructor for correctness
-func (problem mathProblem) Solve() int {
+func (problem mathProblem) Solve() (int, error) {
 	switch problem.operator {
 	case '+':
 		result := 0
 		for _, operand := range problem.operands {
 			result += operand
 		}
-		return result
+		return result, nil
 	case '*':
 		result := 1
 		for _, operand := range problem.operands {
 			result *= operand
 		}
-		return result
+		return result, nil
 	default:
-		panic("invalid operator")
-	}
-}
-
-// a raw math problem is a 2D grid of runes, each rune is either a digit or an operator, or ' '
-type mathProblemRaw struct {
-	width  int      // number of columns that were surrounded by a full column of ' '
-	height int      // number of lines in the input
-	grid   [][]rune // 2D grid of runes, each rune is either a digit or an operator, or ' '
-}
-
-// make a raw math problem from a 2D grid of runes
-func makeMathProblemRaw(input [][]rune) mathProblemRaw {
-	return mathProblemRaw{
-		width:  len(input[0]),
-		height: len(input),
-		grid:   input,
+		return 0, errors.New("invalid operator")
 	}
 }
 
-func parseStringToRawProblems(input string) []mathProblemRaw {
-	lineStrings := strings.Split(input, "\n")
-	lines := make([][]rune, 0)
-	maxWidth := 0
-
-	for _, lineStr := range lineStrings {
-		// only trim carriage returns, preserve all spaces for columnar reading
-		lineStr = strings.TrimRight(lineStr, "\r")
-		if len(lineStr) == 0 {
-			continue
-		}
-		line := []rune(lineStr)
-		lines = append(lines, line)
-		if len(line) > maxWidth {
-			maxWidth = len(line)
-		}
-	}
-
-	if len(lines) == 0 {
-		return []mathProblemRaw{}
-	}
-
-	// find the spaces between problems (using maxWidth to check all possible columns)
-	// a column is a boundary if it's all spaces in all rows that have that column
-	isBoundaryColumn := make([]bool, maxWidth)
-	for col := 0; col < maxWidth; col++ {
-		allSpaces := true
-		hasAnyContent := false
-		for row := 0; row < len(lines); row++ {
-			if col < len(lines[row]) {
-				hasAnyContent = true
-				if lines[row][col] != ' ' {
-					allSpaces = false
-					break
-				}
-			}
-		}
-		// only consider it a boundary if there's content and it's all spaces
-		isBoundaryColumn[col] = hasAnyContent && allSpaces
+// split the worksheet into one grid of runes per problem, each rune is either
+// a digit or an operator, or ' '
+// problems are separated by columns that are all ' ', short lines are padded with ' '
+func parseStringToRawProblems(input string) ([]grid.Grid[rune], error) {
+	worksheet, err := grid.ParseRunes(strings.NewReader(input), ' ')
+	if err != nil {
+		return nil, err
 	}
 
-	// extract problems
-	rawProblems := make([]mathProblemRaw, 0)
+	rawProblems := make([]grid.Grid[rune], 0)
 	startCol := -1
-
-	for col := 0; col < maxWidth; col++ {
-		if !isBoundaryColumn[col] {
+	for col := 0; col <= worksheet.Width(); col++ {
+		if col < worksheet.Width() && !isBoundaryColumn(worksheet.Column(col)) {
 			if startCol == -1 {
 				startCol = col
 			}
-		} else {
-			if startCol != -1 {
-				problemWidth := col - startCol
-				problemGrid := make([][]rune, len(lines))
-				for row := 0; row < len(lines); row++ {
-					problemGrid[row] = make([]rune, problemWidth)
-					// copy what exists, pad with spaces if row is shorter
-					for c := 0; c < problemWidth; c++ {
-						srcCol := startCol + c
-						if srcCol < len(lines[row]) {
-							problemGrid[row][c] = lines[row][srcCol]
-						} else {
-							problemGrid[row][c] = ' '
-						}
-					}
-				}
-				rawProblems = append(rawProblems, makeMathProblemRaw(problemGrid))
-				startCol = -1
-			}
+			continue
+		}
+		if startCol != -1 {
+			rawProblems = append(rawProblems, worksheet.Columns(startCol, col))
+			startCol = -1
 		}
 	}
+	return rawProblems, nil
+}
 
-	if startCol != -1 {
-		problemWidth := maxWidth - startCol
-		problemGrid := make([][]rune, len(lines))
-		for row := 0; row < len(lines); row++ {
-			problemGrid[row] = make([]rune, problemWidth)
-			// copy what exists, pad with spaces if row is shorter
-			for c := 0; c < problemWidth; c++ {
-				srcCol := startCol + c
-				if srcCol < len(lines[row]) {
-					problemGrid[row][c] = lines[row][srcCol]
-				} else {
-					problemGrid[row][c] = ' '
-				}
-			}
+// a column between problems is all spaces
+func isBoundaryColumn(column []rune) bool {
+	for _, cell := range column {
+		if cell != ' ' {
+			return false
 		}
-		rawProblems = append(rawProblems, makeMathProblemRaw(problemGrid))
 	}
-
-	return rawProblems
+	return true
 }
 
 // input is in this format:
-// 123 328  51 64
 //
-//	45 64  387 23
-//	 6 98  215 314
+//	123 328  51 64
+//	 45 64  387 23
+//	  6 98  215 314
+//	*   +   *   +
 //
-// *   +   *   +
 // problems are arranged horizontally, separated by columns of spaces
 // return a list of raw math problems; might make more sense to return constructed actual problems
-func ReadInput(input string, columnar bool) []mathProblem {
-	rawProblems := parseStringToRawProblems(input)
+func ReadInput(input string, columnar bool) ([]mathProblem, error) {
+	rawProblems, err := parseStringToRawProblems(input)
+	if err != nil {
+		return nil, err
+	}
 	problems := make([]mathProblem, 0)
 	// if columnar, add in reverse order
 	if columnar {
 		for i := len(rawProblems) - 1; i >= 0; i-- {
-			problems = append(problems, makeMathProblemColumnar(rawProblems[i].grid))
+			problem, err := makeMathProblemColumnar(rawProblems[i])
+			if err != nil {
+				return nil, fmt.Errorf("problem %d: %w", i+1, err)
+			}
+			problems = append(problems, problem)
 		}
 	} else {
-		for _, rawProblem := range rawProblems {
-			problems = append(problems, makeMathProblem(rawProblem.grid))
+		for i, rawProblem := range rawProblems {
+			problem, err := makeMathProblem(rawProblem)
+			if err != nil {
+				return nil, fmt.Errorf("problem %d: %w", i+1, err)
+			}
+			problems = append(problems, problem)
 		}
 	}
-	return problems
+	return problems, nil
 }
 
-func SolveDay6Part1(input string) int {
-	problems := ReadInput(input, false)
+// sum the answers of all problems in the input
+func solveAll(input string, columnar bool) (int, error) {
+	problems, err := ReadInput(input, columnar)
+	if err != nil {
+		return 0, err
+	}
 	total := 0
 	for _, problem := range problems {
-		answer := problem.Solve()
+		answer, err := problem.Solve()
+		if err != nil {
+			return 0, err
+		}
 		total += answer
 	}
-	return total
+	return total, nil
 }
 
-func SolveDay6Part2(input string) int {
-	problems := ReadInput(input, true)
-	total := 0
-	for _, problem := range problems {
-		answer := problem.Solve()
-		total += answer
-	}
-	return total
+func SolveDay6Part1(input string) (int, error) {
+	return solveAll(input, false)
+}
+
+func SolveDay6Part2(input string) (int, error) {
+	return solveAll(input, true)
 }
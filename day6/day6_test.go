@@ -2,6 +2,8 @@ package day6
 
 import (
 	"testing"
+
+	"aoc2025/golden"
 )
 
 const kDay6SampleInput = `
@@ -35,43 +37,86 @@ const kDay6SampleOutputPart2 = 3263827
 
 func TestReadInput(t *testing.T) {
 	t.Run("Part 1", func(t *testing.T) {
-		problems := ReadInput(kDay6SampleInput, false)
+		problems, err := ReadInput(kDay6SampleInput, false)
+		if err != nil {
+			t.Fatalf("ReadInput() unexpected error %v", err)
+		}
 		if len(problems) != len(kDay6Part1ExpectedProblems) {
 			t.Errorf("Expected %d problems, got %d", len(kDay6Part1ExpectedProblems), len(problems))
 			return
 		}
 		for i, expected := range kDay6Part1ExpectedProblems {
-			actual := problems[i]
-			if actual.Solve() != expected.result {
-				t.Errorf("Problem %d: expected result %d, got %d", i, expected.result, actual.Solve())
+			actual, err := problems[i].Solve()
+			if err != nil {
+				t.Errorf("Problem %d: unexpected error %v", i, err)
+			}
+			if actual != expected.result {
+				t.Errorf("Problem %d: expected result %d, got %d", i, expected.result, actual)
 			}
 		}
 	})
 	t.Run("Part 2", func(t *testing.T) {
-		problems := ReadInput(kDay6SampleInput, true)
+		problems, err := ReadInput(kDay6SampleInput, true)
+		if err != nil {
+			t.Fatalf("ReadInput() unexpected error %v", err)
+		}
 		if len(problems) != len(kDay6Part2ExpectedProblems) {
 			t.Errorf("Expected %d problems, got %d", len(kDay6Part2ExpectedProblems), len(problems))
 			return
 		}
 		for i, expected := range kDay6Part2ExpectedProblems {
-			actual := problems[i]
-			if actual.Solve() != expected.result {
-				t.Errorf("Problem %d: expected result %d, got %d", i, expected.result, actual.Solve())
+			actual, err := problems[i].Solve()
+			if err != nil {
+				t.Errorf("Problem %d: unexpected error %v", i, err)
+			}
+			if actual != expected.result {
+				t.Errorf("Problem %d: expected result %d, got %d", i, expected.result, actual)
 			}
 		}
 	})
 }
 
 func TestSolvePart1(t *testing.T) {
-	result := SolveDay6Part1(kDay6SampleInput)
+	result, err := SolveDay6Part1(kDay6SampleInput)
+	if err != nil {
+		t.Fatalf("SolveDay6Part1() unexpected error %v", err)
+	}
 	if result != kDay6SampleOutputPart1 {
 		t.Errorf("Expected %d, got %d", kDay6SampleOutputPart1, result)
 	}
 }
 
 func TestSolvePart2(t *testing.T) {
-	result := SolveDay6Part2(kDay6SampleInput)
+	result, err := SolveDay6Part2(kDay6SampleInput)
+	if err != nil {
+		t.Fatalf("SolveDay6Part2() unexpected error %v", err)
+	}
 	if result != kDay6SampleOutputPart2 {
 		t.Errorf("Expected %d, got %d", kDay6SampleOutputPart2, result)
 	}
 }
+
+func TestReadInputInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"no operator", "12\n34"},
+		{"invalid character", "12\n3x\n+ "},
+		{"single operand", "1\n+"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := ReadInput(test.input, false); err == nil {
+				t.Errorf("ReadInput(%q, false) expected error, got nil", test.input)
+			}
+			if _, err := ReadInput(test.input, true); err == nil {
+				t.Errorf("ReadInput(%q, true) expected error, got nil", test.input)
+			}
+		})
+	}
+}
+
+func TestGolden(t *testing.T) {
+	golden.Run(t, Day6{})
+}
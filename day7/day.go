@@ -1,14 +1,27 @@
 package day7
 
-// Day7 implements the Day interface for day 7
+import (
+	"context"
+
+	"aoc2025/registry"
+	"aoc2025/solver"
+)
+
+// Day7 implements the Solver interface for day 7
 type Day7 struct{}
 
-// Part1 implements the Day interface
-func (d Day7) Part1(input string) interface{} {
-	return SolveDay7Part1(input)
+func init() {
+	registry.Register(registry.Entry{Year: 2025, Day: 7, Title: "Laboratories", Solver: Day7{}})
+}
+
+// Part1 implements the Solver interface
+func (d Day7) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay7Part1(input)
+	return solver.Int(answer), err
 }
 
-// Part2 implements the Day interface
-func (d Day7) Part2(input string) (interface{}, bool) {
-	return SolveDay7Part2(input), true
+// Part2 implements the Solver interface
+func (d Day7) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay7Part2(input)
+	return solver.Int(answer), err
 }
@@ -2,14 +2,27 @@ package day7
 
 import (
 	"strings"
+
+	"aoc2025/grid"
 )
 
 type DiagramRow struct {
 	exits map[int]bool
 }
 
+// Diagram is the manifold, rows shorter than the longest are padded with ' '
+// which blocks a beam like the sides do
 type Diagram struct {
-	rows [][]rune
+	rows grid.Grid[rune]
+}
+
+// ParseDiagram reads a diagram, skipping blank lines
+func ParseDiagram(input string) (*Diagram, error) {
+	rows, err := grid.ParseRunes(strings.NewReader(input), ' ')
+	if err != nil {
+		return nil, err
+	}
+	return &Diagram{rows: rows}, nil
 }
 
 type Position struct {
@@ -20,21 +33,16 @@ type Position struct {
 // naive solution took too much time and space.
 // had to add memoization to make it work.
 func (d *Diagram) CountPathsFromS() int {
-	if len(d.rows) == 0 {
+	if d.rows.Height() == 0 {
 		return 0
 	}
 
 	// Find S in the first row
-	var sCol int = -1
-	for i, char := range d.rows[0] {
-		if char == 'S' {
-			sCol = i
-			break
-		}
-	}
-	if sCol == -1 {
+	start, found := d.rows.Rows(0, 1).Find(func(char rune) bool { return char == 'S' })
+	if !found {
 		return 0
 	}
+	sCol := start.X
 
 	// cache results for each position
 	memo := make(map[Position]int)
@@ -49,38 +57,30 @@ func (d *Diagram) CountPathsFromS() int {
 		}
 
 		// base case: reached end of diagram
-		if row >= len(d.rows) {
+		if row >= d.rows.Height() {
 			memo[pos] = 1
 			return 1
 		}
 
 		// check if we've gone off the sides
-		if col < 0 || col >= len(d.rows[row]) {
+		char, ok := d.rows.Get(grid.Point{X: col, Y: row})
+		if !ok {
 			memo[pos] = 0
 			return 0
 		}
 
-		char := d.rows[row][col]
-
 		// if we hit a splitter, sum paths from left and right branches
 		if char == '^' {
 			nextRow := row + 1
 
 			// check if we've reached the end
-			if nextRow >= len(d.rows) {
+			if nextRow >= d.rows.Height() {
 				memo[pos] = 1
 				return 1
 			}
 
-			count := 0
-			// left branch (col - 1)
-			if col-1 >= 0 && col-1 < len(d.rows[nextRow]) {
-				count += countPaths(nextRow, col-1)
-			}
-			// right branch (col + 1)
-			if col+1 >= 0 && col+1 < len(d.rows[nextRow]) {
-				count += countPaths(nextRow, col+1)
-			}
+			// left branch (col - 1) and right branch (col + 1), off the sides counts 0
+			count := countPaths(nextRow, col-1) + countPaths(nextRow, col+1)
 
 			memo[pos] = count
 			return count
@@ -154,18 +154,17 @@ func NewDiagramRow(
 	return row
 }
 
-func SolveDay7Part1(input string) int {
-	lines := strings.Split(input, "\n")
-	linesAsRunes := make([][]rune, len(lines))
-	for i, line := range lines {
-		linesAsRunes[i] = []rune(line)
+func SolveDay7Part1(input string) (int, error) {
+	diagram, err := ParseDiagram(input)
+	if err != nil {
+		return 0, err
 	}
 	count := 0
 	countedSplitters := make(map[int]map[int]bool)
 	var previousRow *DiagramRow
-	for rowIndex := range linesAsRunes {
+	for rowIndex := 0; rowIndex < diagram.rows.Height(); rowIndex++ {
 		previousRow = NewDiagramRow(
-			linesAsRunes[rowIndex],
+			diagram.rows.Row(rowIndex),
 			rowIndex,
 			previousRow,
 			func(splitterPos int) {
@@ -178,22 +177,13 @@ func SolveDay7Part1(input string) int {
 				}
 			})
 	}
-	return count
+	return count, nil
 }
 
-func SolveDay7Part2(input string) int {
-	input = strings.TrimSpace(input)
-	lines := strings.Split(input, "\n")
-	nonEmptyLines := make([]string, 0, len(lines))
-	for _, line := range lines {
-		if strings.TrimSpace(line) != "" {
-			nonEmptyLines = append(nonEmptyLines, line)
-		}
-	}
-
-	linesAsRunes := make([][]rune, len(nonEmptyLines))
-	for i, line := range nonEmptyLines {
-		linesAsRunes[i] = []rune(line)
+func SolveDay7Part2(input string) (int, error) {
+	diagram, err := ParseDiagram(input)
+	if err != nil {
+		return 0, err
 	}
-	return (&Diagram{rows: linesAsRunes}).CountPathsFromS()
+	return diagram.CountPathsFromS(), nil
 }
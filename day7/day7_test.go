@@ -1,8 +1,9 @@
 package day7
 
 import (
-	"strings"
 	"testing"
+
+	"aoc2025/golden"
 )
 
 const kDay7SampleInput = `.......S.......
@@ -251,7 +252,10 @@ func TestSolveDay7Part1(t *testing.T) {
 		{input: kDay7SampleInput, expected: kDay7SampleOutputPart1},
 	}
 	for _, test := range tests {
-		result := SolveDay7Part1(test.input)
+		result, err := SolveDay7Part1(test.input)
+		if err != nil {
+			t.Fatalf("SolveDay7Part1(%s) unexpected error %v", test.input, err)
+		}
 		if result != test.expected {
 			t.Errorf("SolveDay7Part1(%s) = %d, expected %d", test.input, result, test.expected)
 		}
@@ -279,12 +283,10 @@ func TestDiagram_CountPathsFromS(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			lines := strings.Split(test.input, "\n")
-			linesAsRunes := make([][]rune, len(lines))
-			for i, line := range lines {
-				linesAsRunes[i] = []rune(line)
+			diagram, err := ParseDiagram(test.input)
+			if err != nil {
+				t.Fatalf("ParseDiagram(%s) unexpected error %v", test.input, err)
 			}
-			diagram := &Diagram{rows: linesAsRunes}
 			result := diagram.CountPathsFromS()
 			if result != test.expected {
 				t.Errorf("CountPathsFromS() = %d, expected %d", result, test.expected)
@@ -302,7 +304,10 @@ func TestSolveDay7Part2(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.input, func(t *testing.T) {
-			result := SolveDay7Part2(test.input)
+			result, err := SolveDay7Part2(test.input)
+			if err != nil {
+				t.Fatalf("SolveDay7Part2(%s) unexpected error %v", test.input, err)
+			}
 			if result != test.expected {
 				t.Errorf("SolveDay7Part2(%s) = %d, expected %d", test.input, result, test.expected)
 			}
@@ -328,15 +333,20 @@ func TestCountPathsFromS_Optimized(t *testing.T) {
 ^`,
 			expected: 1,
 		},
+		{
+			name: "Beam runs off a short row",
+			input: `..S
+..
+...`,
+			expected: 0,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			lines := strings.Split(test.input, "\n")
-			linesAsRunes := make([][]rune, len(lines))
-			for i, line := range lines {
-				linesAsRunes[i] = []rune(line)
+			diagram, err := ParseDiagram(test.input)
+			if err != nil {
+				t.Fatalf("ParseDiagram(%s) unexpected error %v", test.input, err)
 			}
-			diagram := &Diagram{rows: linesAsRunes}
 			result := diagram.CountPathsFromS()
 			if result != test.expected {
 				t.Errorf("CountPathsFromS_Optimized() = %d, expected %d", result, test.expected)
@@ -344,3 +354,7 @@ func TestCountPathsFromS_Optimized(t *testing.T) {
 		})
 	}
 }
+
+func TestGolden(t *testing.T) {
+	golden.Run(t, Day7{})
+}
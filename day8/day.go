@@ -1,14 +1,40 @@
 package day8
 
-// Day8 implements the Day interface for day 8
+import (
+	"context"
+	"io"
+
+	"aoc2025/registry"
+	"aoc2025/solver"
+)
+
+// Day8 implements the Solver interface for day 8
 type Day8 struct{}
 
-// Part1 implements the Day interface
-func (d Day8) Part1(input string) interface{} {
-	return SolveDay8Part1(input)
+func init() {
+	registry.Register(registry.Entry{Year: 2025, Day: 8, Title: "Playground", Solver: Day8{}})
+}
+
+// Part1 implements the Solver interface
+func (d Day8) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay8Part1(input)
+	return solver.Int(answer), err
+}
+
+// Part2 implements the Solver interface
+func (d Day8) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay8Part2(input)
+	return solver.Int(answer), err
+}
+
+// Part1Reader implements the Streamer interface
+func (d Day8) Part1Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	answer, err := SolveDay8Part1Reader(r)
+	return solver.Int(answer), err
 }
 
-// Part2 implements the Day interface
-func (d Day8) Part2(input string) (interface{}, bool) {
-	return SolveDay8Part2(input), true
+// Part2Reader implements the Streamer interface
+func (d Day8) Part2Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	answer, err := SolveDay8Part2Reader(r)
+	return solver.Int(answer), err
 }
@@ -4,17 +4,18 @@ import (
 	"container/heap"
 	"errors"
 	"fmt"
-	"math"
+	"io"
+	"slices"
 	"sort"
-	"strconv"
 	"strings"
+
+	"aoc2025/disjoint"
+	"aoc2025/geom"
+	"aoc2025/input"
 )
 
-type Coordinate struct {
-	x int
-	y int
-	z int
-}
+// Coordinate is the position of a junction box
+type Coordinate = geom.Point3
 
 type CoordinatePair struct {
 	Coordinate1 Coordinate
@@ -34,56 +35,17 @@ func (p CoordinatePair) String() string {
 	return fmt.Sprintf("{%v %v}", p.Coordinate1, p.Coordinate2)
 }
 
-func (c Coordinate) Distance(other Coordinate) float64 {
-	dx := float64(c.x - other.x)
-	dy := float64(c.y - other.y)
-	dz := float64(c.z - other.z)
-	return math.Sqrt(dx*dx + dy*dy + dz*dz)
-}
-
-func (c Coordinate) Equals(other Coordinate) bool {
-	return c.x == other.x && c.y == other.y && c.z == other.z
-}
-
-func (c Coordinate) NotEquals(other Coordinate) bool {
-	return !c.Equals(other)
+func CoordinateFromString(s string) (Coordinate, error) {
+	return geom.Parse[Coordinate](s)
 }
 
-func (c Coordinate) String() string {
-	return fmt.Sprintf("%d,%d,%d", c.x, c.y, c.z)
+func ReadInput(text string) ([]Coordinate, error) {
+	return ReadInputReader(strings.NewReader(text))
 }
 
-func CoordinateFromString(s string) (Coordinate, error) {
-	parts := strings.Split(s, ",")
-	if len(parts) != 3 {
-		return Coordinate{}, errors.New("invalid coordinate string, expected 3 parts")
-	}
-	x, err := strconv.Atoi(strings.TrimSpace(parts[0]))
-	if err != nil {
-		return Coordinate{}, err
-	}
-	y, err := strconv.Atoi(strings.TrimSpace(parts[1]))
-	if err != nil {
-		return Coordinate{}, err
-	}
-	z, err := strconv.Atoi(strings.TrimSpace(parts[2]))
-	if err != nil {
-		return Coordinate{}, err
-	}
-	return Coordinate{x: x, y: y, z: z}, nil
-}
-
-func ReadInput(input string) ([]Coordinate, error) {
-	lines := strings.Split(input, "\n")
-	coordinates := []Coordinate{}
-	for _, line := range lines {
-		coordinate, err := CoordinateFromString(line)
-		if err != nil {
-			return nil, err
-		}
-		coordinates = append(coordinates, coordinate)
-	}
-	return coordinates, nil
+// ReadInputReader reads one coordinate per line from r
+func ReadInputReader(r io.Reader) ([]Coordinate, error) {
+	return input.Parse(r, input.Strict, CoordinateFromString)
 }
 
 type PairWithDistance struct {
@@ -128,7 +90,7 @@ func PairsByDistance(coordinates []Coordinate, limit int) []PairWithDistance {
 	for i, coordinate := range coordinates {
 		for j := i + 1; j < len(coordinates); j++ {
 			otherCoordinate := coordinates[j]
-			distance := coordinate.Distance(otherCoordinate)
+			distance := geom.Euclidean(coordinate, otherCoordinate)
 			pair := PairWithDistance{
 				Distance: distance,
 				Pair:     CoordinatePair{Coordinate1: coordinate, Coordinate2: otherCoordinate},
@@ -154,76 +116,34 @@ func PairsByDistance(coordinates []Coordinate, limit int) []PairWithDistance {
 	return pairs
 }
 
-func GroupCoordinates(pairs []PairWithDistance, allCoordinates []Coordinate) []map[Coordinate]bool {
-	groups := []map[Coordinate]bool{}
-	coordinatesInGroups := make(map[Coordinate]bool)
-
+// connect joins the coordinates of every pair, in order
+func connect(sets *disjoint.Sets[Coordinate], pairs []PairWithDistance) {
 	for _, pair := range pairs {
-		if len(groups) == 0 {
-			groups = append(groups, map[Coordinate]bool{pair.Pair.Coordinate1: true, pair.Pair.Coordinate2: true})
-			coordinatesInGroups[pair.Pair.Coordinate1] = true
-			coordinatesInGroups[pair.Pair.Coordinate2] = true
-		} else {
-			group1Index := -1
-			group2Index := -1
-			for i, group := range groups {
-				hasC1 := group[pair.Pair.Coordinate1]
-				hasC2 := group[pair.Pair.Coordinate2]
-				if hasC1 {
-					group1Index = i
-				}
-				if hasC2 {
-					group2Index = i
-				}
-			}
-
-			if group1Index == -1 && group2Index == -1 {
-				groups = append(groups, map[Coordinate]bool{pair.Pair.Coordinate1: true, pair.Pair.Coordinate2: true})
-				coordinatesInGroups[pair.Pair.Coordinate1] = true
-				coordinatesInGroups[pair.Pair.Coordinate2] = true
-			} else if group1Index != -1 && group2Index == -1 {
-				groups[group1Index][pair.Pair.Coordinate2] = true
-				coordinatesInGroups[pair.Pair.Coordinate2] = true
-			} else if group1Index == -1 && group2Index != -1 {
-				groups[group2Index][pair.Pair.Coordinate1] = true
-				coordinatesInGroups[pair.Pair.Coordinate1] = true
-			} else if group1Index != group2Index {
-				for coord := range groups[group2Index] {
-					groups[group1Index][coord] = true
-				}
-				groups = append(groups[:group2Index], groups[group2Index+1:]...)
-			}
-		}
+		sets.Union(pair.Pair.Coordinate1, pair.Pair.Coordinate2)
 	}
+}
 
-	for _, coord := range allCoordinates {
-		if !coordinatesInGroups[coord] {
-			groups = append(groups, map[Coordinate]bool{coord: true})
-		}
-	}
+// GroupCoordinates returns the circuits left after connecting every pair,
+// largest first, circuits of the same size in the order of their smallest coordinate
+func GroupCoordinates(pairs []PairWithDistance, allCoordinates []Coordinate) []map[Coordinate]bool {
+	sets := disjoint.New(allCoordinates...)
+	connect(sets, pairs)
 
-	sort.Slice(groups, func(i, j int) bool {
-		if len(groups[i]) != len(groups[j]) {
-			return len(groups[i]) > len(groups[j])
-		}
-		var coordI, coordJ Coordinate
-		for c := range groups[i] {
-			coordI = c
-			break
-		}
-		for c := range groups[j] {
-			coordJ = c
-			break
+	components := sets.Components()
+	slices.SortStableFunc(components, func(a, b []Coordinate) int {
+		if len(a) != len(b) {
+			return len(b) - len(a)
 		}
-		if coordI.x != coordJ.x {
-			return coordI.x < coordJ.x
-		}
-		if coordI.y != coordJ.y {
-			return coordI.y < coordJ.y
-		}
-		return coordI.z < coordJ.z
+		return geom.Compare(slices.MinFunc(a, geom.Compare), slices.MinFunc(b, geom.Compare))
 	})
 
+	groups := make([]map[Coordinate]bool, len(components))
+	for i, component := range components {
+		groups[i] = make(map[Coordinate]bool, len(component))
+		for _, coord := range component {
+			groups[i][coord] = true
+		}
+	}
 	return groups
 }
 
@@ -234,95 +154,64 @@ func getNPairsForPart1(numCoordinates int) int {
 	return 1000
 }
 
-func SolveDay8Part1(input string) interface{} {
-	coordinates, err := ReadInput(input)
+func SolveDay8Part1(input string) (int, error) {
+	return SolveDay8Part1Reader(strings.NewReader(input))
+}
+
+// SolveDay8Part1Reader is SolveDay8Part1 parsing the coordinates as they are read from r
+func SolveDay8Part1Reader(r io.Reader) (int, error) {
+	coordinates, err := ReadInputReader(r)
 	if err != nil {
-		return -1
+		return 0, err
 	}
 	n := getNPairsForPart1(len(coordinates))
-	pairs := PairsByDistance(coordinates, n)
-	groups := GroupCoordinates(pairs, coordinates)
+	sets := disjoint.New(coordinates...)
+	connect(sets, PairsByDistance(coordinates, n))
 
-	if len(groups) < 3 {
-		return 0
+	sizes := sets.Sizes()
+	if len(sizes) < 3 {
+		return 0, fmt.Errorf("need at least 3 circuits, found %d", len(sizes))
 	}
-	return len(groups[0]) * len(groups[1]) * len(groups[2])
+	return sizes[0] * sizes[1] * sizes[2], nil
 }
 
-func FindFirstConnectingPair(initialGroups []map[Coordinate]bool, allPairs []PairWithDistance, pairsProcessed map[CoordinatePair]bool) (CoordinatePair, bool) {
-	groups := make([]map[Coordinate]bool, len(initialGroups))
-	for i, group := range initialGroups {
-		groups[i] = make(map[Coordinate]bool)
-		for coord := range group {
-			groups[i][coord] = true
-		}
-	}
-
+// FindFirstConnectingPair connects the pairs in order and returns the one
+// that joins every coordinate into a single circuit
+func FindFirstConnectingPair(coordinates []Coordinate, allPairs []PairWithDistance) (CoordinatePair, bool) {
+	sets := disjoint.New(coordinates...)
+	var connecting CoordinatePair
+	found := false
+	sets.WhenCount(1, func(a, b Coordinate) {
+		connecting, found = CoordinatePair{Coordinate1: a, Coordinate2: b}, true
+	})
 	for _, pair := range allPairs {
-		if pairsProcessed[pair.Pair] {
-			continue
-		}
-
-		group1Index := -1
-		group2Index := -1
-		for i, group := range groups {
-			hasC1 := group[pair.Pair.Coordinate1]
-			hasC2 := group[pair.Pair.Coordinate2]
-			if hasC1 {
-				group1Index = i
-			}
-			if hasC2 {
-				group2Index = i
-			}
-		}
-
-		if group1Index != -1 && group2Index != -1 && group1Index != group2Index {
-			for coord := range groups[group2Index] {
-				groups[group1Index][coord] = true
-			}
-			groups = append(groups[:group2Index], groups[group2Index+1:]...)
-
-			if len(groups) == 1 {
-				return pair.Pair, true
-			}
-		} else if group1Index == -1 && group2Index == -1 {
-			groups[0][pair.Pair.Coordinate1] = true
-			groups[0][pair.Pair.Coordinate2] = true
-		} else if group1Index != -1 && group2Index == -1 {
-			groups[group1Index][pair.Pair.Coordinate2] = true
-		} else if group1Index == -1 && group2Index != -1 {
-			groups[group2Index][pair.Pair.Coordinate1] = true
+		if sets.Union(pair.Pair.Coordinate1, pair.Pair.Coordinate2) && found {
+			return connecting, true
 		}
 	}
-
 	return CoordinatePair{}, false
 }
 
-func SolveDay8Part2(input string) interface{} {
-	coordinates, err := ReadInput(input)
+func SolveDay8Part2(input string) (int, error) {
+	return SolveDay8Part2Reader(strings.NewReader(input))
+}
+
+// SolveDay8Part2Reader is SolveDay8Part2 parsing the coordinates as they are read from r
+func SolveDay8Part2Reader(r io.Reader) (int, error) {
+	coordinates, err := ReadInputReader(r)
 	if err != nil {
-		return -1
+		return 0, err
 	}
 
-	n := getNPairsForPart1(len(coordinates))
-	pairs := PairsByDistance(coordinates, n)
-	groups := GroupCoordinates(pairs, coordinates)
-
-	if len(groups) <= 1 {
-		return 0
+	if len(coordinates) <= 1 {
+		return 0, fmt.Errorf("need at least 2 junction boxes, found %d", len(coordinates))
 	}
 
 	allPairs := PairsByDistance(coordinates, len(coordinates)*(len(coordinates)-1)/2)
-
-	pairsProcessed := make(map[CoordinatePair]bool)
-	for _, pair := range pairs {
-		pairsProcessed[pair.Pair] = true
-	}
-
-	connectingPair, found := FindFirstConnectingPair(groups, allPairs, pairsProcessed)
+	connectingPair, found := FindFirstConnectingPair(coordinates, allPairs)
 	if !found {
-		return 0
+		return 0, errors.New("no pair connects all junction boxes")
 	}
 
-	return connectingPair.Coordinate1.x * connectingPair.Coordinate2.x
+	return connectingPair.Coordinate1.X() * connectingPair.Coordinate2.X(), nil
 }
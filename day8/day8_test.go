@@ -1,6 +1,12 @@
 package day8
 
-import "testing"
+import (
+	"errors"
+	"testing"
+
+	"aoc2025/golden"
+	"aoc2025/input"
+)
 
 const kDay8SampleInput = `162,817,812
 57,618,57
@@ -24,12 +30,12 @@ const kDay8SampleInput = `162,817,812
 425,690,689`
 
 var kClosestPairSampleCoordinates = CoordinatePair{
-	Coordinate1: Coordinate{x: 162, y: 817, z: 812},
-	Coordinate2: Coordinate{x: 425, y: 690, z: 689},
+	Coordinate1: Coordinate{162, 817, 812},
+	Coordinate2: Coordinate{425, 690, 689},
 }
 var kSecondClosestPairSampleCoordinates = CoordinatePair{
-	Coordinate1: Coordinate{x: 162, y: 817, z: 812},
-	Coordinate2: Coordinate{x: 431, y: 825, z: 988},
+	Coordinate1: Coordinate{162, 817, 812},
+	Coordinate2: Coordinate{431, 825, 988},
 }
 
 var kGroupSizesFromSampleInput = []int{5, 4, 2, 2, 1, 1, 1, 1, 1, 1, 1}
@@ -47,21 +53,39 @@ func TestReadInput(t *testing.T) {
 	}
 }
 
+func TestReadInputTrailingNewline(t *testing.T) {
+	coordinates, err := ReadInput(kDay8SampleInput + "\n\n")
+	if err != nil || len(coordinates) != 20 {
+		t.Errorf("ReadInput() with trailing newlines = %d, %v, expected 20", len(coordinates), err)
+	}
+}
+
+func TestReadInputReportsPosition(t *testing.T) {
+	_, err := ReadInput("162,817,812\n57,6x8,57\n")
+	var posErr *input.Error
+	if !errors.As(err, &posErr) || posErr.Line != 2 || posErr.Column != 4 {
+		t.Errorf("ReadInput() error = %v, expected line 2, column 4", err)
+	}
+	if _, err := ReadInput("162,817\n"); !errors.As(err, &posErr) || posErr.Line != 1 {
+		t.Errorf("ReadInput() of a short coordinate error = %v, expected line 1", err)
+	}
+}
+
 func TestCoordinateFromString(t *testing.T) {
 
 	coordinate, err := CoordinateFromString("162,817,812")
 	if err != nil {
 		t.Errorf("CoordinateFromString(%s) unexpected error %v", "162,817,812", err)
 	}
-	if coordinate.x != 162 || coordinate.y != 817 || coordinate.z != 812 {
+	if coordinate != (Coordinate{162, 817, 812}) {
 		t.Errorf("CoordinateFromString(%s) = %v, expected 162,817,812", "162,817,812", coordinate)
 	}
 }
 
 func TestCoordinatePairEquals(t *testing.T) {
-	c1 := Coordinate{x: 1, y: 2, z: 3}
-	c2 := Coordinate{x: 4, y: 5, z: 6}
-	c3 := Coordinate{x: 7, y: 8, z: 9}
+	c1 := Coordinate{1, 2, 3}
+	c2 := Coordinate{4, 5, 6}
+	c3 := Coordinate{7, 8, 9}
 
 	pair1 := CoordinatePair{Coordinate1: c1, Coordinate2: c2}
 	pair2 := CoordinatePair{Coordinate1: c2, Coordinate2: c1} // reversed order
@@ -85,9 +109,9 @@ func TestCoordinatePairEquals(t *testing.T) {
 }
 
 func TestCoordinatePairNotEquals(t *testing.T) {
-	c1 := Coordinate{x: 1, y: 2, z: 3}
-	c2 := Coordinate{x: 4, y: 5, z: 6}
-	c3 := Coordinate{x: 7, y: 8, z: 9}
+	c1 := Coordinate{1, 2, 3}
+	c2 := Coordinate{4, 5, 6}
+	c3 := Coordinate{7, 8, 9}
 
 	pair1 := CoordinatePair{Coordinate1: c1, Coordinate2: c2}
 	pair2 := CoordinatePair{Coordinate1: c1, Coordinate2: c3}
@@ -102,8 +126,8 @@ func TestCoordinatePairNotEquals(t *testing.T) {
 }
 
 func TestPairWithDistanceEquals(t *testing.T) {
-	c1 := Coordinate{x: 1, y: 2, z: 3}
-	c2 := Coordinate{x: 4, y: 5, z: 6}
+	c1 := Coordinate{1, 2, 3}
+	c2 := Coordinate{4, 5, 6}
 	pair := CoordinatePair{Coordinate1: c1, Coordinate2: c2}
 
 	pwd1 := PairWithDistance{Distance: 5.0, Pair: pair}
@@ -173,10 +197,10 @@ func TestPairsByDistanceOrdering(t *testing.T) {
 
 	// Verify specific expected pairs
 	expectedPairs := []CoordinatePair{
-		{Coordinate1: Coordinate{x: 162, y: 817, z: 812}, Coordinate2: Coordinate{x: 425, y: 690, z: 689}},
-		{Coordinate1: Coordinate{x: 162, y: 817, z: 812}, Coordinate2: Coordinate{x: 431, y: 825, z: 988}},
-		{Coordinate1: Coordinate{x: 906, y: 360, z: 560}, Coordinate2: Coordinate{x: 805, y: 96, z: 715}},
-		{Coordinate1: Coordinate{x: 431, y: 825, z: 988}, Coordinate2: Coordinate{x: 425, y: 690, z: 689}},
+		{Coordinate1: Coordinate{162, 817, 812}, Coordinate2: Coordinate{425, 690, 689}},
+		{Coordinate1: Coordinate{162, 817, 812}, Coordinate2: Coordinate{431, 825, 988}},
+		{Coordinate1: Coordinate{906, 360, 560}, Coordinate2: Coordinate{805, 96, 715}},
+		{Coordinate1: Coordinate{431, 825, 988}, Coordinate2: Coordinate{425, 690, 689}},
 	}
 
 	if len(pairs) < len(expectedPairs) {
@@ -212,25 +236,62 @@ func TestGroupCoordinatesFromInput(t *testing.T) {
 }
 
 func TestSolveDay8Part1(t *testing.T) {
-	result := SolveDay8Part1(kDay8SampleInput)
+	result, err := SolveDay8Part1(kDay8SampleInput)
+	if err != nil {
+		t.Fatalf("SolveDay8Part1(%s) unexpected error %v", kDay8SampleInput, err)
+	}
 	if result != kDay8SampleOutputPart1 {
 		t.Errorf("SolveDay8Part1(%s) = %d, expected %d", kDay8SampleInput, result, kDay8SampleOutputPart1)
 	}
 }
 
 func TestSolveDay8Part2(t *testing.T) {
-	result := SolveDay8Part2(kDay8SampleInput)
+	result, err := SolveDay8Part2(kDay8SampleInput)
+	if err != nil {
+		t.Fatalf("SolveDay8Part2(%s) unexpected error %v", kDay8SampleInput, err)
+	}
 	if result != kDay8SampleOutputPart2 {
 		t.Errorf("SolveDay8Part2(%s) = %d, expected %d", kDay8SampleInput, result, kDay8SampleOutputPart2)
 	}
 }
 
+func TestFindFirstConnectingPair(t *testing.T) {
+	coordinates, err := ReadInput(kDay8SampleInput)
+	if err != nil {
+		t.Fatalf("ReadInput(%s) unexpected error %v", kDay8SampleInput, err)
+	}
+	allPairs := PairsByDistance(coordinates, len(coordinates)*(len(coordinates)-1)/2)
+	pair, found := FindFirstConnectingPair(coordinates, allPairs)
+	expected := CoordinatePair{Coordinate1: Coordinate{216, 146, 977}, Coordinate2: Coordinate{117, 168, 530}}
+	if !found || pair.NotEquals(expected) {
+		t.Errorf("FindFirstConnectingPair() = %v, %v, expected %v, true", pair, found, expected)
+	}
+	if _, found := FindFirstConnectingPair(coordinates, allPairs[:10]); found {
+		t.Errorf("FindFirstConnectingPair() with 10 pairs found a pair connecting every coordinate")
+	}
+}
+
+func TestSolveDay8InvalidInput(t *testing.T) {
+	if _, err := SolveDay8Part1("1,2"); err == nil {
+		t.Errorf("SolveDay8Part1() expected error for malformed coordinate, got nil")
+	}
+	if _, err := SolveDay8Part2("1,2,x"); err == nil {
+		t.Errorf("SolveDay8Part2() expected error for malformed coordinate, got nil")
+	}
+	if _, err := SolveDay8Part1("1,2,3\n4,5,6"); err == nil {
+		t.Errorf("SolveDay8Part1() expected error for fewer than 3 circuits, got nil")
+	}
+	if _, err := SolveDay8Part2("1,2,3"); err == nil {
+		t.Errorf("SolveDay8Part2() expected error for a single junction box, got nil")
+	}
+}
+
 func TestGroupCoordinates(t *testing.T) {
 	// Arrange
-	c1 := Coordinate{x: 1, y: 2, z: 3}
-	c2 := Coordinate{x: 4, y: 5, z: 6}
-	c3 := Coordinate{x: 7, y: 8, z: 9}
-	c4 := Coordinate{x: 10, y: 11, z: 12}
+	c1 := Coordinate{1, 2, 3}
+	c2 := Coordinate{4, 5, 6}
+	c3 := Coordinate{7, 8, 9}
+	c4 := Coordinate{10, 11, 12}
 	coordinates := []Coordinate{c1, c2, c3, c4}
 
 	// Create pairs: (c1,c2), (c2,c3), (c4,c4) - last one should create new group
@@ -273,3 +334,7 @@ func TestGroupCoordinates(t *testing.T) {
 		t.Errorf("GroupCoordinates() did not create separate group for c4")
 	}
 }
+
+func TestGolden(t *testing.T) {
+	golden.Run(t, Day8{})
+}
@@ -1,14 +1,40 @@
 package day9
 
-// Day9 implements the Day interface for day 9
+import (
+	"context"
+	"io"
+
+	"aoc2025/registry"
+	"aoc2025/solver"
+)
+
+// Day9 implements the Solver interface for day 9
 type Day9 struct{}
 
-// Part1 implements the Day interface
-func (d Day9) Part1(input string) interface{} {
-	return SolveDay9Part1(input)
+func init() {
+	registry.Register(registry.Entry{Year: 2025, Day: 9, Title: "Movie Theater", Solver: Day9{}})
+}
+
+// Part1 implements the Solver interface
+func (d Day9) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay9Part1(input)
+	return solver.Int(answer), err
+}
+
+// Part2 implements the Solver interface
+func (d Day9) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	answer, err := SolveDay9Part2(ctx, input)
+	return solver.Int(answer), err
+}
+
+// Part1Reader implements the Streamer interface
+func (d Day9) Part1Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	answer, err := SolveDay9Part1Reader(r)
+	return solver.Int(answer), err
 }
 
-// Part2 implements the Day interface
-func (d Day9) Part2(input string) (interface{}, bool) {
-	return SolveDay9Part2(input), true
+// Part2Reader implements the Streamer interface
+func (d Day9) Part2Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	answer, err := SolveDay9Part2Reader(ctx, r)
+	return solver.Int(answer), err
 }
@@ -1,20 +1,24 @@
 package day9
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"runtime"
-	"sort"
-	"strconv"
+	"slices"
 	"strings"
 	"sync"
+
+	"aoc2025/geom"
+	"aoc2025/grid"
+	"aoc2025/input"
 )
 
-type Coordinate struct {
-	x int
-	y int
-}
+// Coordinate is the position of a red tile
+type Coordinate = geom.Point2
 
+// Perimeter is the loop of red tiles, closed by repeating the first at the end
 type Perimeter []Coordinate
 
 type PerimeterIndex struct {
@@ -37,27 +41,7 @@ func NewPerimeterIndex(perimeter Perimeter) *PerimeterIndex {
 		}
 	}
 
-	minX, minY := perimeter[0].x, perimeter[0].y
-	maxX, maxY := perimeter[0].x, perimeter[0].y
-	for _, c := range perimeter {
-		if c.x < minX {
-			minX = c.x
-		}
-		if c.x > maxX {
-			maxX = c.x
-		}
-		if c.y < minY {
-			minY = c.y
-		}
-		if c.y > maxY {
-			maxY = c.y
-		}
-	}
-
-	bounds := Rectangle{
-		C1: Coordinate{x: minX, y: minY},
-		C2: Coordinate{x: maxX, y: maxY},
-	}
+	bounds := Rectangle{geom.BoxOf(perimeter...)}
 
 	return &PerimeterIndex{
 		perimeter:          perimeter,
@@ -154,80 +138,24 @@ func MakePerimeter(coordinates []Coordinate) Perimeter {
 	return perimeter
 }
 
+// polygon is the loop without the repeated first coordinate
+func (p Perimeter) polygon() geom.Polygon {
+	return geom.Polygon(p[:len(p)-1])
+}
+
+// Contains reports whether c is inside the loop or on it
 func (p Perimeter) Contains(c Coordinate) bool {
 	if len(p) < 4 {
 		return false
 	}
-
-	for i := 0; i < len(p)-1; i++ {
-		p1 := p[i]
-		p2 := p[i+1]
-
-		dx := p2.x - p1.x
-		dy := p2.y - p1.y
-		dx1 := c.x - p1.x
-		dy1 := c.y - p1.y
-
-		cross := dx1*dy - dy1*dx
-		if cross == 0 {
-			if dx != 0 {
-				if (dx > 0 && c.x >= p1.x && c.x <= p2.x) || (dx < 0 && c.x >= p2.x && c.x <= p1.x) {
-					return true
-				}
-			} else {
-				if (dy > 0 && c.y >= p1.y && c.y <= p2.y) || (dy < 0 && c.y >= p2.y && c.y <= p1.y) {
-					return true
-				}
-			}
-		}
-	}
-
-	inside := false
-	for i := 0; i < len(p)-1; i++ {
-		p1 := p[i]
-		p2 := p[i+1]
-
-		if (p1.y > c.y) != (p2.y > c.y) {
-			if p2.y != p1.y {
-				xIntersect := float64(p1.x) + float64(c.y-p1.y)*float64(p2.x-p1.x)/float64(p2.y-p1.y)
-				if float64(c.x) < xIntersect {
-					inside = !inside
-				}
-			}
-		}
-	}
-
-	return inside
+	return p.polygon().Contains(c)
 }
 
 func (p Perimeter) IsOnEdge(c Coordinate) bool {
 	if len(p) < 4 {
 		return false
 	}
-
-	for i := 0; i < len(p)-1; i++ {
-		p1 := p[i]
-		p2 := p[i+1]
-
-		dx := p2.x - p1.x
-		dy := p2.y - p1.y
-		dx1 := c.x - p1.x
-		dy1 := c.y - p1.y
-
-		cross := dx1*dy - dy1*dx
-		if cross == 0 {
-			if dx != 0 {
-				if (dx > 0 && c.x >= p1.x && c.x <= p2.x) || (dx < 0 && c.x >= p2.x && c.x <= p1.x) {
-					return true
-				}
-			} else {
-				if (dy > 0 && c.y >= p1.y && c.y <= p2.y) || (dy < 0 && c.y >= p2.y && c.y <= p1.y) {
-					return true
-				}
-			}
-		}
-	}
-	return false
+	return p.polygon().OnBoundary(c)
 }
 
 // ..............
@@ -243,126 +171,66 @@ func (p Perimeter) String() string {
 	maxX := 0
 	maxY := 0
 	for _, c := range p {
-		if c.x > maxX {
-			maxX = c.x
-		}
-		if c.y > maxY {
-			maxY = c.y
-		}
+		maxX = max(maxX, c.X())
+		maxY = max(maxY, c.Y())
 	}
 
-	nodeSet := make(map[Coordinate]bool)
-	for _, c := range p {
-		nodeSet[c] = true
-	}
-
-	grid := make([][]string, maxY+1)
-	for i := range grid {
-		grid[i] = make([]string, maxX+1)
-		for j := range grid[i] {
-			coord := Coordinate{x: j, y: i}
-			if nodeSet[coord] {
-				grid[i][j] = "#"
-			} else if p.Contains(coord) {
-				grid[i][j] = "X"
-			} else {
-				grid[i][j] = "."
-			}
+	picture := grid.New[rune](maxX+1, maxY+1)
+	for point := range picture.All() {
+		if p.Contains(Coordinate{point.X, point.Y}) {
+			picture.Set(point, 'X')
+		} else {
+			picture.Set(point, '.')
 		}
 	}
-	gridStrings := make([]string, len(grid))
-	for i, row := range grid {
-		gridStrings[i] = strings.Join(row, "")
+	for _, c := range p {
+		picture.Set(grid.Point{X: c.X(), Y: c.Y()}, '#')
 	}
-	return strings.Join(gridStrings, "\n")
+	return picture.Render(func(char rune) rune { return char })
 }
 
 func CoordinateFromString(s string) (Coordinate, error) {
-	parts := strings.Split(s, ",")
-	if len(parts) != 2 {
-		return Coordinate{}, errors.New("invalid coordinate string, expected 2 parts")
-	}
-	x, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return Coordinate{}, err
-	}
-	y, err := strconv.Atoi(parts[1])
-	if err != nil {
-		return Coordinate{}, err
-	}
-	return Coordinate{x: x, y: y}, nil
-}
-
-func (c Coordinate) Min(other Coordinate) Coordinate {
-	return Coordinate{x: min(c.x, other.x), y: min(c.y, other.y)}
-}
-
-func (c Coordinate) Max(other Coordinate) Coordinate {
-	return Coordinate{x: max(c.x, other.x), y: max(c.y, other.y)}
-}
-
-func (c Coordinate) String() string {
-	return fmt.Sprintf("(%d,%d)", c.x, c.y)
+	return geom.Parse[Coordinate](s)
 }
 
+// Rectangle is a box of tiles with red tiles in opposite corners
 type Rectangle struct {
-	C1 Coordinate
-	C2 Coordinate
-}
-
-func (r Rectangle) Contains(c Coordinate) bool {
-	return c.x >= r.C1.x && c.x <= r.C2.x && c.y >= r.C1.y && c.y <= r.C2.y
+	geom.Box[Coordinate]
 }
 
 func (r Rectangle) String() string {
-	return fmt.Sprintf("Rectangle{C1: %v, C2: %v}", r.C1, r.C2)
+	return fmt.Sprintf("Rectangle{Min: %v, Max: %v}", r.Min, r.Max)
 }
 
 func MakeRectangle(c1 Coordinate, c2 Coordinate) (Rectangle, error) {
-	minCoord := c1.Min(c2)
-	maxCoord := c1.Max(c2)
-
-	if minCoord.x == maxCoord.x || minCoord.y == maxCoord.y {
+	box := geom.BoxOf(c1, c2)
+	if box.Size(0) == 1 || box.Size(1) == 1 {
 		return Rectangle{}, errors.New("coordinates must form a valid rectangle with non-zero width and height")
 	}
-
-	return Rectangle{C1: minCoord, C2: maxCoord}, nil
-}
-
-func (r Rectangle) Area() int {
-	return (r.C2.x - r.C1.x + 1) * (r.C2.y - r.C1.y + 1)
-}
-
-func (r Rectangle) Corners() []Coordinate {
-	return []Coordinate{
-		r.C1,
-		{x: r.C2.x, y: r.C1.y},
-		{x: r.C1.x, y: r.C2.y},
-		r.C2,
-	}
+	return Rectangle{box}, nil
 }
 
 func (r Rectangle) IsFullyContainedInPerimeter(perimeter Perimeter) bool {
-	for x := r.C1.x; x <= r.C2.x; x++ {
-		point := Coordinate{x: x, y: r.C2.y}
+	for x := r.Min.X(); x <= r.Max.X(); x++ {
+		point := Coordinate{x, r.Max.Y()}
 		if !perimeter.Contains(point) {
 			return false
 		}
 	}
-	for x := r.C1.x; x <= r.C2.x; x++ {
-		point := Coordinate{x: x, y: r.C1.y}
+	for x := r.Min.X(); x <= r.Max.X(); x++ {
+		point := Coordinate{x, r.Min.Y()}
 		if !perimeter.Contains(point) {
 			return false
 		}
 	}
-	for y := r.C1.y + 1; y < r.C2.y; y++ {
-		point := Coordinate{x: r.C1.x, y: y}
+	for y := r.Min.Y() + 1; y < r.Max.Y(); y++ {
+		point := Coordinate{r.Min.X(), y}
 		if !perimeter.Contains(point) {
 			return false
 		}
 	}
-	for y := r.C1.y + 1; y < r.C2.y; y++ {
-		point := Coordinate{x: r.C2.x, y: y}
+	for y := r.Min.Y() + 1; y < r.Max.Y(); y++ {
+		point := Coordinate{r.Max.X(), y}
 		if !perimeter.Contains(point) {
 			return false
 		}
@@ -371,31 +239,30 @@ func (r Rectangle) IsFullyContainedInPerimeter(perimeter Perimeter) bool {
 }
 
 func (r Rectangle) IsFullyContainedInPerimeterIndex(index *PerimeterIndex) bool {
-	if r.C1.x < index.bounds.C1.x || r.C2.x > index.bounds.C2.x ||
-		r.C1.y < index.bounds.C1.y || r.C2.y > index.bounds.C2.y {
+	if !index.bounds.ContainsBox(r.Box) {
 		return false
 	}
 
-	for x := r.C1.x; x <= r.C2.x; x++ {
-		point := Coordinate{x: x, y: r.C2.y}
+	for x := r.Min.X(); x <= r.Max.X(); x++ {
+		point := Coordinate{x, r.Max.Y()}
 		if !index.Contains(point) {
 			return false
 		}
 	}
-	for x := r.C1.x; x <= r.C2.x; x++ {
-		point := Coordinate{x: x, y: r.C1.y}
+	for x := r.Min.X(); x <= r.Max.X(); x++ {
+		point := Coordinate{x, r.Min.Y()}
 		if !index.Contains(point) {
 			return false
 		}
 	}
-	for y := r.C1.y + 1; y < r.C2.y; y++ {
-		point := Coordinate{x: r.C1.x, y: y}
+	for y := r.Min.Y() + 1; y < r.Max.Y(); y++ {
+		point := Coordinate{r.Min.X(), y}
 		if !index.Contains(point) {
 			return false
 		}
 	}
-	for y := r.C1.y + 1; y < r.C2.y; y++ {
-		point := Coordinate{x: r.C2.x, y: y}
+	for y := r.Min.Y() + 1; y < r.Max.Y(); y++ {
+		point := Coordinate{r.Max.X(), y}
 		if !index.Contains(point) {
 			return false
 		}
@@ -403,41 +270,35 @@ func (r Rectangle) IsFullyContainedInPerimeterIndex(index *PerimeterIndex) bool
 	return true
 }
 
-func ReadInput(input string, sortCoordinates bool) []Coordinate {
-	lines := strings.Split(input, "\n")
-	coordinates := make([]Coordinate, 0, len(lines))
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if len(line) == 0 {
-			continue
-		}
-		c, err := CoordinateFromString(line)
-		if err != nil {
-			continue
-		}
-		coordinates = append(coordinates, c)
+func ReadInput(text string, sortCoordinates bool) ([]Coordinate, error) {
+	return ReadInputReader(strings.NewReader(text), sortCoordinates)
+}
+
+// ReadInputReader reads one coordinate per line from r
+func ReadInputReader(r io.Reader, sortCoordinates bool) ([]Coordinate, error) {
+	coordinates, err := input.Parse(r, input.Strict, CoordinateFromString)
+	if err != nil {
+		return nil, err
 	}
 	if sortCoordinates {
-		sort.SliceStable(coordinates, func(i, j int) bool {
-			if coordinates[i].x == coordinates[j].x {
-				return coordinates[i].y < coordinates[j].y
-			}
-			return coordinates[i].x < coordinates[j].x
-		})
+		slices.SortStableFunc(coordinates, geom.Compare)
 	}
-	return coordinates
+	return coordinates, nil
 }
 
-func ReadInputAndMakePerimeter(input string) (coordinates []Coordinate, perimeter Perimeter) {
-	coordinates = ReadInput(input, false)
-	perimeter = MakePerimeter(coordinates)
-	sort.SliceStable(coordinates, func(i, j int) bool {
-		if coordinates[i].x == coordinates[j].x {
-			return coordinates[i].y < coordinates[j].y
-		}
-		return coordinates[i].x < coordinates[j].x
-	})
-	return coordinates, perimeter
+func ReadInputAndMakePerimeter(input string) (coordinates []Coordinate, perimeter Perimeter, err error) {
+	coordinates, err = ReadInput(input, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return coordinates, makePerimeterAndSort(coordinates), nil
+}
+
+// makePerimeterAndSort builds the perimeter in input order, then sorts the coordinates
+func makePerimeterAndSort(coordinates []Coordinate) Perimeter {
+	perimeter := MakePerimeter(coordinates)
+	slices.SortStableFunc(coordinates, geom.Compare)
+	return perimeter
 }
 
 func FindLargestRectangle(coordinates []Coordinate) Rectangle {
@@ -464,7 +325,8 @@ func FindLargestRectangle(coordinates []Coordinate) Rectangle {
 	return largestRectangle
 }
 
-func FindLargestRectangleInPerimeter(perimeter Perimeter, coordinates []Coordinate) (Rectangle, error) {
+// FindLargestRectangleInPerimeter stops early with ctx.Err() when ctx is cancelled
+func FindLargestRectangleInPerimeter(ctx context.Context, perimeter Perimeter, coordinates []Coordinate) (Rectangle, error) {
 	if len(perimeter) < 4 {
 		return Rectangle{}, errors.New("perimeter must have at least 4 coordinates")
 	}
@@ -478,18 +340,21 @@ func FindLargestRectangleInPerimeter(perimeter Perimeter, coordinates []Coordina
 	}
 
 	if numWorkers == 1 {
-		return findLargestRectangleSequential(index, coordinates)
+		return findLargestRectangleSequential(ctx, index, coordinates)
 	}
 
-	return findLargestRectangleParallel(index, coordinates, numWorkers)
+	return findLargestRectangleParallel(ctx, index, coordinates, numWorkers)
 }
 
-func findLargestRectangleSequential(index *PerimeterIndex, coordinates []Coordinate) (Rectangle, error) {
+func findLargestRectangleSequential(ctx context.Context, index *PerimeterIndex, coordinates []Coordinate) (Rectangle, error) {
 	var largestRectangle Rectangle
 	largestArea := 0
 	found := false
 
 	for i := 0; i < len(coordinates); i++ {
+		if err := ctx.Err(); err != nil {
+			return Rectangle{}, err
+		}
 		for j := len(coordinates) - 1; j > i; j-- {
 			rectangle, err := MakeRectangle(coordinates[i], coordinates[j])
 			if err != nil {
@@ -514,7 +379,9 @@ func findLargestRectangleSequential(index *PerimeterIndex, coordinates []Coordin
 	return largestRectangle, nil
 }
 
-func findLargestRectangleParallel(index *PerimeterIndex, coordinates []Coordinate, numWorkers int) (Rectangle, error) {
+// the producer and workers all stop when ctx is cancelled, so no goroutine
+// outlives the call
+func findLargestRectangleParallel(ctx context.Context, index *PerimeterIndex, coordinates []Coordinate, numWorkers int) (Rectangle, error) {
 	type result struct {
 		rectangle Rectangle
 		area      int
@@ -530,7 +397,11 @@ func findLargestRectangleParallel(index *PerimeterIndex, coordinates []Coordinat
 		defer close(work)
 		for i := 0; i < len(coordinates); i++ {
 			for j := len(coordinates) - 1; j > i; j-- {
-				work <- workItem{i: i, j: j}
+				select {
+				case work <- workItem{i: i, j: j}:
+				case <-ctx.Done():
+					return
+				}
 			}
 		}
 	}()
@@ -541,6 +412,9 @@ func findLargestRectangleParallel(index *PerimeterIndex, coordinates []Coordinat
 		go func() {
 			defer wg.Done()
 			for item := range work {
+				if ctx.Err() != nil {
+					return
+				}
 				rectangle, err := MakeRectangle(coordinates[item.i], coordinates[item.j])
 				if err != nil {
 					continue
@@ -571,23 +445,43 @@ func findLargestRectangleParallel(index *PerimeterIndex, coordinates []Coordinat
 		}
 	}
 
+	if err := ctx.Err(); err != nil {
+		return Rectangle{}, err
+	}
+
 	if !found {
 		return Rectangle{}, errors.New("no rectangle found in perimeter")
 	}
 	return largestRectangle, nil
 }
 
-func SolveDay9Part1(input string) interface{} {
-	coordinates := ReadInput(input, true)
-	largestRectangle := FindLargestRectangle(coordinates)
-	return largestRectangle.Area()
+func SolveDay9Part1(input string) (int, error) {
+	return SolveDay9Part1Reader(strings.NewReader(input))
+}
+
+// SolveDay9Part1Reader is SolveDay9Part1 parsing the coordinates as they are read from r
+func SolveDay9Part1Reader(r io.Reader) (int, error) {
+	coordinates, err := ReadInputReader(r, true)
+	if err != nil {
+		return 0, err
+	}
+	return FindLargestRectangle(coordinates).Area(), nil
+}
+
+func SolveDay9Part2(ctx context.Context, input string) (int, error) {
+	return SolveDay9Part2Reader(ctx, strings.NewReader(input))
 }
 
-func SolveDay9Part2(input string) interface{} {
-	coordinates, perimeter := ReadInputAndMakePerimeter(input)
-	largestRectangle, err := FindLargestRectangleInPerimeter(perimeter, coordinates)
+// SolveDay9Part2Reader is SolveDay9Part2 parsing the coordinates as they are read from r
+func SolveDay9Part2Reader(ctx context.Context, r io.Reader) (int, error) {
+	coordinates, err := ReadInputReader(r, false)
+	if err != nil {
+		return 0, err
+	}
+	perimeter := makePerimeterAndSort(coordinates)
+	largestRectangle, err := FindLargestRectangleInPerimeter(ctx, perimeter, coordinates)
 	if err != nil {
-		return 0
+		return 0, err
 	}
-	return largestRectangle.Area()
+	return largestRectangle.Area(), nil
 }
@@ -1,9 +1,17 @@
 package day9
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
+
+	"aoc2025/geom"
+	"aoc2025/golden"
+	"aoc2025/input"
 )
 
 const kDay9SampleInput = `7,1
@@ -19,19 +27,36 @@ const kDay9SampleOutputPart1 = 50 // 11,1 and 2,5
 const kDay9SampleOutputPart2 = 24 // 9,5 and 2,3
 
 func TestSolveDay9Part1(t *testing.T) {
-	result := SolveDay9Part1(kDay9SampleInput)
+	result, err := SolveDay9Part1(kDay9SampleInput)
+	if err != nil {
+		t.Fatalf("SolveDay9Part1(%s) unexpected error %v", kDay9SampleInput, err)
+	}
 	if result != kDay9SampleOutputPart1 {
 		t.Errorf("SolveDay9Part1(%s) = %d, expected %d", kDay9SampleInput, result, kDay9SampleOutputPart1)
 	}
 }
 
 func TestSolveDay9Part2(t *testing.T) {
-	result := SolveDay9Part2(kDay9SampleInput)
+	result, err := SolveDay9Part2(context.Background(), kDay9SampleInput)
+	if err != nil {
+		t.Fatalf("SolveDay9Part2(%s) unexpected error %v", kDay9SampleInput, err)
+	}
 	if result != kDay9SampleOutputPart2 {
 		t.Errorf("SolveDay9Part2(%s) = %d, expected %d", kDay9SampleInput, result, kDay9SampleOutputPart2)
 	}
 }
 
+func TestSolveDay9Part1ReaderReportsBadLine(t *testing.T) {
+	_, err := SolveDay9Part1Reader(strings.NewReader("7,1\n11,1\n11;7\n"))
+	var posErr *input.Error
+	if !errors.As(err, &posErr) || posErr.Line != 3 || posErr.Column != 1 {
+		t.Errorf("SolveDay9Part1Reader() error = %v, expected line 3, column 1", err)
+	}
+	if result, err := (Day9{}).Part1(context.Background(), "7,1\n11,1\nfoo\n"); err == nil {
+		t.Errorf("Day9.Part1() = %v, expected an error for a bad line", result)
+	}
+}
+
 func TestMakeRectangle(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -42,16 +67,16 @@ func TestMakeRectangle(t *testing.T) {
 	}{
 		{
 			name:     "(11,1) and (2,5)",
-			c1:       Coordinate{x: 11, y: 1},
-			c2:       Coordinate{x: 2, y: 5},
-			expected: Rectangle{C1: Coordinate{x: 2, y: 1}, C2: Coordinate{x: 11, y: 5}},
+			c1:       Coordinate{11, 1},
+			c2:       Coordinate{2, 5},
+			expected: Rectangle{geom.Box[Coordinate]{Min: Coordinate{2, 1}, Max: Coordinate{11, 5}}},
 			wantErr:  false,
 		},
 		{
 			name:     "(2,5) and (11,1)",
-			c1:       Coordinate{x: 2, y: 5},
-			c2:       Coordinate{x: 11, y: 1},
-			expected: Rectangle{C1: Coordinate{x: 2, y: 1}, C2: Coordinate{x: 11, y: 5}},
+			c1:       Coordinate{2, 5},
+			c2:       Coordinate{11, 1},
+			expected: Rectangle{geom.Box[Coordinate]{Min: Coordinate{2, 1}, Max: Coordinate{11, 5}}},
 			wantErr:  false,
 		},
 	}
@@ -64,8 +89,7 @@ func TestMakeRectangle(t *testing.T) {
 				return
 			}
 			if !tt.wantErr {
-				if got.C1.x != tt.expected.C1.x || got.C1.y != tt.expected.C1.y ||
-					got.C2.x != tt.expected.C2.x || got.C2.y != tt.expected.C2.y {
+				if got != tt.expected {
 					t.Errorf("MakeRectangle() = %v, expected %v", got, tt.expected)
 				}
 				area := got.Area()
@@ -76,7 +100,10 @@ func TestMakeRectangle(t *testing.T) {
 }
 
 func TestMakePerimeter(t *testing.T) {
-	coordinates := ReadInput(kDay9SampleInput, false)
+	coordinates, err := ReadInput(kDay9SampleInput, false)
+	if err != nil {
+		t.Fatalf("ReadInput(%s) unexpected error %v", kDay9SampleInput, err)
+	}
 	perimeter := MakePerimeter(coordinates)
 	t.Logf("Perimeter: %v", perimeter)
 	drawing := perimeter.String()
@@ -86,8 +113,8 @@ func TestMakePerimeter(t *testing.T) {
 	// Verify that all perimeter nodes are marked with #
 	for _, c := range perimeter {
 		lines := strings.Split(drawing, "\n")
-		if c.y < len(lines) && c.x < len(lines[c.y]) {
-			char := string(lines[c.y][c.x])
+		if c.Y() < len(lines) && c.X() < len(lines[c.Y()]) {
+			char := string(lines[c.Y()][c.X()])
 			if char != "#" {
 				t.Errorf("Perimeter node %v should be marked with #, but found %s", c, char)
 			}
@@ -96,8 +123,11 @@ func TestMakePerimeter(t *testing.T) {
 }
 
 func TestFindLargestRectangleInPerimeter(t *testing.T) {
-	coordinates, perimeter := ReadInputAndMakePerimeter(kDay9SampleInput)
-	largestRectangle, err := FindLargestRectangleInPerimeter(perimeter, coordinates)
+	coordinates, perimeter, err := ReadInputAndMakePerimeter(kDay9SampleInput)
+	if err != nil {
+		t.Fatalf("ReadInputAndMakePerimeter(%s) unexpected error %v", kDay9SampleInput, err)
+	}
+	largestRectangle, err := FindLargestRectangleInPerimeter(context.Background(), perimeter, coordinates)
 	if err != nil {
 		t.Fatalf("FindLargestRectangleInPerimeter(%v, %v) returned error: %v", perimeter, coordinates, err)
 	}
@@ -105,8 +135,8 @@ func TestFindLargestRectangleInPerimeter(t *testing.T) {
 }
 
 func TestAreaCalculation(t *testing.T) {
-	c1 := Coordinate{x: 11, y: 1}
-	c2 := Coordinate{x: 2, y: 5}
+	c1 := Coordinate{11, 1}
+	c2 := Coordinate{2, 5}
 
 	rectangle, err := MakeRectangle(c1, c2)
 	if err != nil {
@@ -114,10 +144,41 @@ func TestAreaCalculation(t *testing.T) {
 	}
 
 	area := rectangle.Area()
-	t.Logf("Rectangle from (%d,%d) and (%d,%d): %v", c1.x, c1.y, c2.x, c2.y, rectangle)
+	t.Logf("Rectangle from (%d,%d) and (%d,%d): %v", c1.X(), c1.Y(), c2.X(), c2.Y(), rectangle)
 	t.Logf("Area = %d", area)
 
 	if area != 50 {
 		t.Errorf("Area = %d, expected 50", area)
 	}
 }
+
+func TestFindLargestRectangleInPerimeterCancelled(t *testing.T) {
+	// a staircase big enough to use the parallel search
+	coordinates := []Coordinate{}
+	for i := 0; i < 200; i++ {
+		coordinates = append(coordinates, Coordinate{i, i}, Coordinate{i + 1, i})
+	}
+	perimeter := MakePerimeter(coordinates)
+	before := runtime.NumGoroutine()
+
+	for _, timeout := range []time.Duration{0, time.Millisecond} {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		_, err := FindLargestRectangleInPerimeter(ctx, perimeter, coordinates)
+		cancel()
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("FindLargestRectangleInPerimeter() with timeout %v error = %v, expected %v", timeout, err, context.DeadlineExceeded)
+		}
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("%d goroutines still running after cancellation, expected %d", after, before)
+	}
+}
+
+func TestGolden(t *testing.T) {
+	golden.Run(t, Day9{})
+}
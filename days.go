@@ -0,0 +1,15 @@
+package main
+
+// every day registers itself with the registry from init()
+import (
+	_ "aoc2025/day1"
+	_ "aoc2025/day10"
+	_ "aoc2025/day2"
+	_ "aoc2025/day3"
+	_ "aoc2025/day4"
+	_ "aoc2025/day5"
+	_ "aoc2025/day6"
+	_ "aoc2025/day7"
+	_ "aoc2025/day8"
+	_ "aoc2025/day9"
+)
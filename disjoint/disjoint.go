@@ -0,0 +1,172 @@
+// Package disjoint is a union-find over comparable keys: it splits keys into
+// components and joins them, finding a key's component in near constant time
+package disjoint
+
+import "slices"
+
+// Sets keeps keys in disjoint components
+// Add and Union put keys not seen yet in components of their own, queries such
+// as Find leave them out, use New to make one
+type Sets[K comparable] struct {
+	index  map[K]int
+	keys   []K
+	parent []int // parent[i] is i for the root of a component
+	size   []int // size of the component, only kept for roots
+	count  int
+	watch  []watch[K]
+}
+
+// watch is a callback waiting for the number of components to drop to count
+type watch[K comparable] struct {
+	count int
+	f     func(a, b K)
+}
+
+// New returns sets with every key in a component of its own
+func New[K comparable](keys ...K) *Sets[K] {
+	s := &Sets[K]{index: make(map[K]int, len(keys))}
+	for _, k := range keys {
+		s.Add(k)
+	}
+	return s
+}
+
+// Add puts k in a component of its own, it does nothing if k is already there
+func (s *Sets[K]) Add(k K) {
+	s.id(k)
+}
+
+// id returns the position of k, adding it when it is new
+func (s *Sets[K]) id(k K) int {
+	if i, ok := s.lookup(k); ok {
+		return i
+	}
+	i := len(s.keys)
+	s.index[k] = i
+	s.keys = append(s.keys, k)
+	s.parent = append(s.parent, i)
+	s.size = append(s.size, 1)
+	s.count++
+	return i
+}
+
+// lookup returns the position of k, ok is false when k was never added
+func (s *Sets[K]) lookup(k K) (i int, ok bool) {
+	i, ok = s.index[k]
+	return i, ok
+}
+
+// Has reports whether k was added
+func (s *Sets[K]) Has(k K) bool {
+	_, ok := s.lookup(k)
+	return ok
+}
+
+// root returns the root of the component of i, pointing every key on the
+// way straight at it
+func (s *Sets[K]) root(i int) int {
+	r := i
+	for s.parent[r] != r {
+		r = s.parent[r]
+	}
+	for s.parent[i] != r {
+		s.parent[i], i = r, s.parent[i]
+	}
+	return r
+}
+
+// Find returns the key standing for the component of k, ok is false when k was
+// never added
+// two keys are in the same component when Find returns the same key for both
+func (s *Sets[K]) Find(k K) (K, bool) {
+	i, ok := s.lookup(k)
+	if !ok {
+		var zero K
+		return zero, false
+	}
+	return s.keys[s.root(i)], true
+}
+
+// Same reports whether a and b are in the same component, it is false when
+// either was never added
+func (s *Sets[K]) Same(a, b K) bool {
+	i, okA := s.lookup(a)
+	j, okB := s.lookup(b)
+	return okA && okB && s.root(i) == s.root(j)
+}
+
+// Union joins the components of a and b, the smaller into the larger
+// it reports false when they were already joined
+func (s *Sets[K]) Union(a, b K) bool {
+	ra, rb := s.root(s.id(a)), s.root(s.id(b))
+	if ra == rb {
+		return false
+	}
+	if s.size[ra] < s.size[rb] {
+		ra, rb = rb, ra
+	}
+	s.parent[rb] = ra
+	s.size[ra] += s.size[rb]
+	s.count--
+	for _, w := range s.watch {
+		if w.count == s.count {
+			w.f(a, b)
+		}
+	}
+	return true
+}
+
+// WhenCount calls f with the keys of the Union that leaves count components
+func (s *Sets[K]) WhenCount(count int, f func(a, b K)) {
+	s.watch = append(s.watch, watch[K]{count: count, f: f})
+}
+
+// Count is the number of components
+func (s *Sets[K]) Count() int {
+	return s.count
+}
+
+// Len is the number of keys
+func (s *Sets[K]) Len() int {
+	return len(s.keys)
+}
+
+// Size is the number of keys in the component of k, 0 when k was never added
+func (s *Sets[K]) Size(k K) int {
+	i, ok := s.lookup(k)
+	if !ok {
+		return 0
+	}
+	return s.size[s.root(i)]
+}
+
+// Sizes returns the size of every component, largest first
+func (s *Sets[K]) Sizes() []int {
+	sizes := make([]int, 0, s.count)
+	for i := range s.keys {
+		if s.parent[i] == i {
+			sizes = append(sizes, s.size[i])
+		}
+	}
+	slices.Sort(sizes)
+	slices.Reverse(sizes)
+	return sizes
+}
+
+// Components returns the keys of every component in the order they were added,
+// components are ordered by the first key added to them
+func (s *Sets[K]) Components() [][]K {
+	at := make(map[int]int, s.count)
+	components := make([][]K, 0, s.count)
+	for i, k := range s.keys {
+		r := s.root(i)
+		c, ok := at[r]
+		if !ok {
+			c = len(components)
+			at[r] = c
+			components = append(components, make([]K, 0, s.size[r]))
+		}
+		components[c] = append(components[c], k)
+	}
+	return components
+}
@@ -0,0 +1,96 @@
+package disjoint
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUnion(t *testing.T) {
+	s := New("a", "b", "c", "d", "e")
+	tests := []struct {
+		a, b     string
+		joined   bool
+		expected int
+	}{
+		{"a", "b", true, 4},
+		{"c", "d", true, 3},
+		{"b", "a", false, 3},
+		{"a", "d", true, 2},
+		{"b", "c", false, 2},
+		{"f", "g", true, 3},
+	}
+	for _, test := range tests {
+		if result := s.Union(test.a, test.b); result != test.joined {
+			t.Errorf("Union(%q, %q) = %v, expected %v", test.a, test.b, result, test.joined)
+		}
+		if result := s.Count(); result != test.expected {
+			t.Errorf("Count() after Union(%q, %q) = %d, expected %d", test.a, test.b, result, test.expected)
+		}
+	}
+	findA, _ := s.Find("a")
+	findD, _ := s.Find("d")
+	if !s.Same("b", "c") || s.Same("a", "e") || findA != findD {
+		t.Errorf("Same() and Find() do not match the components %v", s.Components())
+	}
+	if result := s.Size("c"); result != 4 {
+		t.Errorf("Size(%q) = %d, expected 4", "c", result)
+	}
+	if result := s.Len(); result != 7 {
+		t.Errorf("Len() = %d, expected 7", result)
+	}
+}
+
+func TestQueriesLeaveUnknownKeysOut(t *testing.T) {
+	s := New("a", "b")
+	s.Union("a", "b")
+	if _, ok := s.Find("x"); ok {
+		t.Errorf("Find(%q) found a key that was never added", "x")
+	}
+	if s.Same("x", "x") || s.Same("a", "x") {
+		t.Errorf("Same() reported a key that was never added in a component")
+	}
+	if result := s.Size("x"); result != 0 {
+		t.Errorf("Size(%q) = %d, expected 0", "x", result)
+	}
+	if s.Has("x") || !s.Has("a") {
+		t.Errorf("Has() = %v, %v, expected false, true", s.Has("x"), s.Has("a"))
+	}
+	if s.Count() != 1 || s.Len() != 2 {
+		t.Errorf("Count(), Len() = %d, %d after queries, expected 1, 2", s.Count(), s.Len())
+	}
+}
+
+func TestSizesAndComponents(t *testing.T) {
+	s := New(1, 2, 3, 4, 5, 6)
+	s.Union(5, 6)
+	s.Union(1, 3)
+	s.Union(6, 1)
+	if result, expected := s.Sizes(), []int{4, 1, 1}; !slices.Equal(result, expected) {
+		t.Errorf("Sizes() = %v, expected %v", result, expected)
+	}
+	result := s.Components()
+	expected := [][]int{{1, 3, 5, 6}, {2}, {4}}
+	if !slices.EqualFunc(result, expected, slices.Equal) {
+		t.Errorf("Components() = %v, expected %v", result, expected)
+	}
+}
+
+func TestWhenCount(t *testing.T) {
+	s := New(1, 2, 3, 4)
+	var last [2]int
+	calls := 0
+	s.WhenCount(1, func(a, b int) {
+		last = [2]int{a, b}
+		calls++
+	})
+	s.Union(1, 2)
+	s.Union(3, 4)
+	s.Union(2, 1)
+	if calls != 0 {
+		t.Errorf("WhenCount(1) called with %d components left", s.Count())
+	}
+	s.Union(4, 2)
+	if calls != 1 || last != [2]int{4, 2} {
+		t.Errorf("WhenCount(1) called %d times with %v, expected once with [4 2]", calls, last)
+	}
+}
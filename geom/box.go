@@ -0,0 +1,74 @@
+package geom
+
+import "fmt"
+
+// Box is an axis aligned box of integer points, Min and Max are both inside it
+type Box[P Point] struct {
+	Min P
+	Max P
+}
+
+// BoxOf returns the smallest box containing every point, points must not be empty
+func BoxOf[P Point](points ...P) Box[P] {
+	box := Box[P]{Min: points[0], Max: points[0]}
+	for _, p := range points[1:] {
+		box = box.Extend(p)
+	}
+	return box
+}
+
+// Extend returns the smallest box containing b and p
+func (b Box[P]) Extend(p P) Box[P] {
+	return Box[P]{Min: Min(b.Min, p), Max: Max(b.Max, p)}
+}
+
+// Contains reports whether p is inside b, its faces included
+func (b Box[P]) Contains(p P) bool {
+	for i := range len(p) {
+		if p[i] < b.Min[i] || p[i] > b.Max[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsBox reports whether all of other is inside b
+func (b Box[P]) ContainsBox(other Box[P]) bool {
+	return b.Contains(other.Min) && b.Contains(other.Max)
+}
+
+// Size is the number of integer points along dimension i
+func (b Box[P]) Size(i int) int {
+	return b.Max[i] - b.Min[i] + 1
+}
+
+// Area is the number of integer points inside b, its volume in 3 dimensions
+func (b Box[P]) Area() int {
+	area := 1
+	for i := range len(b.Min) {
+		area *= b.Size(i)
+	}
+	return area
+}
+
+// Corners returns the 2^n corners of b, corner k takes Max in dimension i
+// when bit i of k is set, so in the plane they are (minX, minY), (maxX, minY),
+// (minX, maxY) and (maxX, maxY)
+func (b Box[P]) Corners() []P {
+	n := len(b.Min)
+	corners := make([]P, 1<<n)
+	for k := range corners {
+		for i := range n {
+			if k&(1<<i) != 0 {
+				corners[k][i] = b.Max[i]
+			} else {
+				corners[k][i] = b.Min[i]
+			}
+		}
+	}
+	return corners
+}
+
+func (b Box[P]) String() string {
+	return fmt.Sprintf("[%s %s]", Format(b.Min), Format(b.Max))
+}
@@ -0,0 +1,52 @@
+package geom
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBoxOf(t *testing.T) {
+	box := BoxOf(Point2{11, 1}, Point2{2, 5}, Point2{7, 3})
+	if expected := (Box[Point2]{Min: Point2{2, 1}, Max: Point2{11, 5}}); box != expected {
+		t.Errorf("BoxOf() = %v, expected %v", box, expected)
+	}
+	if result := box.Area(); result != 50 {
+		t.Errorf("Area() = %d, expected 50", result)
+	}
+}
+
+func TestBoxContains(t *testing.T) {
+	box := Box[Point3]{Min: Point3{0, 0, 0}, Max: Point3{2, 2, 2}}
+	tests := []struct {
+		p        Point3
+		expected bool
+	}{
+		{Point3{1, 1, 1}, true},
+		{Point3{2, 0, 2}, true},
+		{Point3{3, 1, 1}, false},
+		{Point3{1, 1, -1}, false},
+	}
+	for _, test := range tests {
+		if result := box.Contains(test.p); result != test.expected {
+			t.Errorf("%v.Contains(%v) = %v, expected %v", box, test.p, result, test.expected)
+		}
+	}
+	inner := Box[Point3]{Min: Point3{1, 1, 1}, Max: Point3{2, 2, 2}}
+	if !box.ContainsBox(inner) || inner.ContainsBox(box) {
+		t.Errorf("ContainsBox() does not match the boxes %v and %v", box, inner)
+	}
+	if result := box.Area(); result != 27 {
+		t.Errorf("Area() of a 3x3x3 box = %d, expected 27", result)
+	}
+}
+
+func TestCorners(t *testing.T) {
+	box := Box[Point2]{Min: Point2{2, 1}, Max: Point2{11, 5}}
+	expected := []Point2{{2, 1}, {11, 1}, {2, 5}, {11, 5}}
+	if result := box.Corners(); !slices.Equal(result, expected) {
+		t.Errorf("Corners() = %v, expected %v", result, expected)
+	}
+	if result := (Box[Point3]{Max: Point3{1, 1, 1}}).Corners(); len(result) != 8 {
+		t.Errorf("Corners() of a 3D box = %d corners, expected 8", len(result))
+	}
+}
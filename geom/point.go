@@ -0,0 +1,143 @@
+// Package geom is integer geometry: points in 2, 3 or 4 dimensions,
+// axis aligned boxes, distances, orientation and polygons
+// points are arrays, so they compare with == and can be used as map keys
+package geom
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"aoc2025/input"
+)
+
+// Point is a point with integer coordinates in 2, 3 or 4 dimensions
+type Point interface {
+	~[2]int | ~[3]int | ~[4]int
+}
+
+// Point2 is a point in the plane
+type Point2 [2]int
+
+// Point3 is a point in space
+type Point3 [3]int
+
+func (p Point2) X() int { return p[0] }
+func (p Point2) Y() int { return p[1] }
+
+func (p Point2) String() string { return Format(p) }
+
+func (p Point3) X() int { return p[0] }
+func (p Point3) Y() int { return p[1] }
+func (p Point3) Z() int { return p[2] }
+
+func (p Point3) String() string { return Format(p) }
+
+// Parse reads a point written as comma separated coordinates, such as 162,817,812
+func Parse[P Point](s string) (P, error) {
+	var p P
+	values, err := input.Tuple(s, len(p))
+	if err != nil {
+		return p, err
+	}
+	for i := range len(p) {
+		p[i] = values[i]
+	}
+	return p, nil
+}
+
+// Format writes p the way Parse reads it
+func Format[P Point](p P) string {
+	parts := make([]string, len(p))
+	for i := range len(p) {
+		parts[i] = fmt.Sprint(p[i])
+	}
+	return strings.Join(parts, ",")
+}
+
+// Add returns p moved by d
+func Add[P Point](p, d P) P {
+	for i := range len(p) {
+		p[i] += d[i]
+	}
+	return p
+}
+
+// Sub returns the step from q to p
+func Sub[P Point](p, q P) P {
+	for i := range len(p) {
+		p[i] -= q[i]
+	}
+	return p
+}
+
+// Min returns the smallest coordinate of p and q in every dimension
+func Min[P Point](p, q P) P {
+	for i := range len(p) {
+		p[i] = min(p[i], q[i])
+	}
+	return p
+}
+
+// Max returns the largest coordinate of p and q in every dimension
+func Max[P Point](p, q P) P {
+	for i := range len(p) {
+		p[i] = max(p[i], q[i])
+	}
+	return p
+}
+
+// Compare orders points by their first coordinate, then their second and so on
+// it returns -1, 0 or +1 like cmp.Compare, for use with slices.SortFunc
+func Compare[P Point](p, q P) int {
+	for i := range len(p) {
+		switch {
+		case p[i] < q[i]:
+			return -1
+		case p[i] > q[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// Manhattan is the sum of the distances along every axis
+func Manhattan[P Point](p, q P) int {
+	total := 0
+	for i := range len(p) {
+		total += abs(p[i] - q[i])
+	}
+	return total
+}
+
+// Chebyshev is the largest distance along any axis, the number of king moves
+func Chebyshev[P Point](p, q P) int {
+	longest := 0
+	for i := range len(p) {
+		longest = max(longest, abs(p[i]-q[i]))
+	}
+	return longest
+}
+
+// SquaredEuclidean is the square of the straight line distance, exact and
+// ordered the same as Euclidean
+func SquaredEuclidean[P Point](p, q P) int {
+	total := 0
+	for i := range len(p) {
+		d := p[i] - q[i]
+		total += d * d
+	}
+	return total
+}
+
+// Euclidean is the straight line distance
+func Euclidean[P Point](p, q P) float64 {
+	return math.Sqrt(float64(SquaredEuclidean(p, q)))
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,70 @@
+package geom
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestParseAndFormat(t *testing.T) {
+	p3, err := Parse[Point3]("162,817,812")
+	if err != nil || p3 != (Point3{162, 817, 812}) {
+		t.Errorf("Parse[Point3](162,817,812) = %v, %v, expected 162,817,812", p3, err)
+	}
+	p2, err := Parse[Point2](" 7, -1")
+	if err != nil || p2 != (Point2{7, -1}) {
+		t.Errorf("Parse[Point2]( 7, -1) = %v, %v, expected 7,-1", p2, err)
+	}
+	if _, err := Parse[Point2]("1,2,3"); err == nil {
+		t.Errorf("Parse[Point2](1,2,3) expected error, got nil")
+	}
+	if result := p3.String(); result != "162,817,812" {
+		t.Errorf("String() = %q, expected 162,817,812", result)
+	}
+	if p3.X() != 162 || p3.Y() != 817 || p3.Z() != 812 || p2.X() != 7 || p2.Y() != -1 {
+		t.Errorf("X, Y, Z of %v and %v do not match their coordinates", p3, p2)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	p, q := Point2{1, 5}, Point2{4, 2}
+	tests := []struct {
+		name     string
+		result   Point2
+		expected Point2
+	}{
+		{"Add", Add(p, q), Point2{5, 7}},
+		{"Sub", Sub(p, q), Point2{-3, 3}},
+		{"Min", Min(p, q), Point2{1, 2}},
+		{"Max", Max(p, q), Point2{4, 5}},
+	}
+	for _, test := range tests {
+		if test.result != test.expected {
+			t.Errorf("%s(%v, %v) = %v, expected %v", test.name, p, q, test.result, test.expected)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	points := []Point2{{3, 1}, {1, 9}, {3, 0}, {1, 2}}
+	slices.SortFunc(points, Compare)
+	if expected := []Point2{{1, 2}, {1, 9}, {3, 0}, {3, 1}}; !slices.Equal(points, expected) {
+		t.Errorf("SortFunc(Compare) = %v, expected %v", points, expected)
+	}
+}
+
+func TestDistances(t *testing.T) {
+	p, q := Point3{1, 2, 3}, Point3{4, -2, 3}
+	if result := Manhattan(p, q); result != 7 {
+		t.Errorf("Manhattan(%v, %v) = %d, expected 7", p, q, result)
+	}
+	if result := Chebyshev(p, q); result != 4 {
+		t.Errorf("Chebyshev(%v, %v) = %d, expected 4", p, q, result)
+	}
+	if result := SquaredEuclidean(p, q); result != 25 {
+		t.Errorf("SquaredEuclidean(%v, %v) = %d, expected 25", p, q, result)
+	}
+	if result := Euclidean(p, q); math.Abs(result-5) > 1e-12 {
+		t.Errorf("Euclidean(%v, %v) = %v, expected 5", p, q, result)
+	}
+}
@@ -0,0 +1,99 @@
+package geom
+
+// Cross is the z component of the cross product of the steps from o to a and
+// from o to b, twice the signed area of the triangle o, a, b
+func Cross(o, a, b Point2) int {
+	return (a[0]-o[0])*(b[1]-o[1]) - (a[1]-o[1])*(b[0]-o[0])
+}
+
+// Orientation is +1 when a, b, c turn counter clockwise in a y-up plane,
+// -1 when they turn clockwise and 0 when they lie on one line
+// on a grid with y going down the turns are mirrored
+func Orientation(a, b, c Point2) int {
+	switch cross := Cross(a, b, c); {
+	case cross > 0:
+		return 1
+	case cross < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// OnSegment reports whether p lies on the segment from a to b, ends included
+func OnSegment(p, a, b Point2) bool {
+	return Cross(a, b, p) == 0 &&
+		p[0] >= min(a[0], b[0]) && p[0] <= max(a[0], b[0]) &&
+		p[1] >= min(a[1], b[1]) && p[1] <= max(a[1], b[1])
+}
+
+// Polygon is a closed polygon, its last vertex joins back to the first
+type Polygon []Point2
+
+// Edges calls f with the ends of every edge in order, including the closing one
+func (poly Polygon) Edges(f func(a, b Point2)) {
+	for i, a := range poly {
+		f(a, poly[(i+1)%len(poly)])
+	}
+}
+
+// OnBoundary reports whether p lies on an edge of poly
+func (poly Polygon) OnBoundary(p Point2) bool {
+	for i, a := range poly {
+		if OnSegment(p, a, poly[(i+1)%len(poly)]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains reports whether p is inside poly or on its boundary
+// it uses exact integer arithmetic, so points near an edge are never misjudged
+func (poly Polygon) Contains(p Point2) bool {
+	if len(poly) < 3 {
+		return false
+	}
+	if poly.OnBoundary(p) {
+		return true
+	}
+	// count the edges a ray to the right of p crosses, each edge includes its
+	// lower end and excludes its upper one so vertices are only counted once
+	inside := false
+	for i, a := range poly {
+		b := poly[(i+1)%len(poly)]
+		if (a[1] > p[1]) == (b[1] > p[1]) {
+			continue
+		}
+		// p is left of an upward edge or right of a downward one
+		if Orientation(a, b, p)*sign(b[1]-a[1]) > 0 {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// DoubleArea is twice the signed area of poly, positive when its vertices
+// turn counter clockwise in a y-up plane, by the shoelace formula
+func (poly Polygon) DoubleArea() int {
+	area := 0
+	poly.Edges(func(a, b Point2) {
+		area += a[0]*b[1] - b[0]*a[1]
+	})
+	return area
+}
+
+// Bounds is the smallest box containing poly, poly must not be empty
+func (poly Polygon) Bounds() Box[Point2] {
+	return BoxOf(poly...)
+}
+
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,68 @@
+package geom
+
+import "testing"
+
+func TestOrientation(t *testing.T) {
+	tests := []struct {
+		a, b, c  Point2
+		expected int
+	}{
+		{Point2{0, 0}, Point2{4, 0}, Point2{4, 4}, 1},
+		{Point2{0, 0}, Point2{4, 4}, Point2{4, 0}, -1},
+		{Point2{0, 0}, Point2{2, 2}, Point2{5, 5}, 0},
+		// large coordinates are still exact
+		{Point2{0, 0}, Point2{1 << 30, 1<<30 + 1}, Point2{1<<30 - 1, 1 << 30}, 1},
+	}
+	for _, test := range tests {
+		if result := Orientation(test.a, test.b, test.c); result != test.expected {
+			t.Errorf("Orientation(%v, %v, %v) = %d, expected %d", test.a, test.b, test.c, result, test.expected)
+		}
+	}
+}
+
+func TestOnSegment(t *testing.T) {
+	a, b := Point2{1, 1}, Point2{5, 1}
+	for p, expected := range map[Point2]bool{{1, 1}: true, {3, 1}: true, {5, 1}: true, {6, 1}: false, {3, 2}: false} {
+		if result := OnSegment(p, a, b); result != expected {
+			t.Errorf("OnSegment(%v, %v, %v) = %v, expected %v", p, a, b, result, expected)
+		}
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	// the red tiles of the day 9 example, an L shaped loop
+	poly := Polygon{{7, 1}, {11, 1}, {11, 7}, {9, 7}, {9, 5}, {2, 5}, {2, 3}, {7, 3}}
+	tests := []struct {
+		p        Point2
+		expected bool
+	}{
+		{Point2{7, 1}, true},  // vertex
+		{Point2{9, 1}, true},  // edge
+		{Point2{8, 2}, true},  // inside
+		{Point2{4, 4}, true},  // inside the lower arm
+		{Point2{10, 6}, true}, // inside the right arm
+		{Point2{4, 2}, false}, // above the lower arm
+		{Point2{3, 6}, false}, // below the lower arm
+		{Point2{12, 4}, false},
+		{Point2{0, 4}, false},
+	}
+	for _, test := range tests {
+		if result := poly.Contains(test.p); result != test.expected {
+			t.Errorf("Contains(%v) = %v, expected %v", test.p, result, test.expected)
+		}
+	}
+	if result := poly.Bounds(); result != (Box[Point2]{Min: Point2{2, 1}, Max: Point2{11, 7}}) {
+		t.Errorf("Bounds() = %v, expected [2,1 11,7]", result)
+	}
+}
+
+func TestDoubleArea(t *testing.T) {
+	square := Polygon{{0, 0}, {4, 0}, {4, 4}, {0, 4}}
+	if result := square.DoubleArea(); result != 32 {
+		t.Errorf("DoubleArea() = %d, expected 32", result)
+	}
+	reversed := Polygon{{0, 4}, {4, 4}, {4, 0}, {0, 0}}
+	if result := reversed.DoubleArea(); result != -32 {
+		t.Errorf("DoubleArea() clockwise = %d, expected -32", result)
+	}
+}
@@ -0,0 +1,119 @@
+package golden
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"aoc2025/solver"
+)
+
+// Dir is where a day package keeps its golden files
+const Dir = "testdata"
+
+// Pair is an example input and the expected answer for one part
+// stored as <Name>.txt with the input and <Name>.part<Part> with the answer
+type Pair struct {
+	Name   string
+	Part   int
+	Input  string
+	Answer string
+}
+
+func inputFile(dir, name string) string {
+	return filepath.Join(dir, name+".txt")
+}
+
+func answerFile(dir, name string, part int) string {
+	return filepath.Join(dir, fmt.Sprintf("%s.part%d", name, part))
+}
+
+// Load reads every golden pair in dir, ordered by name and part
+// an answer file without its input file is an error, a missing dir gives no pairs
+func Load(dir string) ([]Pair, error) {
+	answerFiles, err := filepath.Glob(filepath.Join(dir, "*.part[12]"))
+	if err != nil {
+		return nil, err
+	}
+	pairs := []Pair{}
+	for _, path := range answerFiles {
+		base := filepath.Base(path)
+		ext := filepath.Ext(base)
+		name := strings.TrimSuffix(base, ext)
+		part, _ := strconv.Atoi(strings.TrimPrefix(ext, ".part"))
+		answer, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		input, err := os.ReadFile(inputFile(dir, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%s has no input file %s", path, inputFile(dir, name))
+		}
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, Pair{Name: name, Part: part, Input: string(input), Answer: strings.TrimSpace(string(answer))})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Name != pairs[j].Name {
+			return pairs[i].Name < pairs[j].Name
+		}
+		return pairs[i].Part < pairs[j].Part
+	})
+	return pairs, nil
+}
+
+// Save writes pairs to dir, pairs with the same name share one input file
+// inputs are written as given, without adding a trailing newline
+func Save(dir string, pairs []Pair) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		if err := os.WriteFile(inputFile(dir, pair.Name), []byte(pair.Input), 0o644); err != nil {
+			return err
+		}
+		if err := os.WriteFile(answerFile(dir, pair.Name, pair.Part), []byte(pair.Answer+"\n"), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run checks a day's solver against every golden pair in the package's testdata directory
+// parts that are not unlocked yet are skipped, as is a day without golden files
+func Run(t *testing.T, s solver.Solver) {
+	t.Helper()
+	pairs, err := Load(Dir)
+	if err != nil {
+		t.Fatalf("loading golden files: %v", err)
+	}
+	if len(pairs) == 0 {
+		t.Skipf("no golden files in %s", Dir)
+	}
+	for _, pair := range pairs {
+		t.Run(fmt.Sprintf("%s part %d", pair.Name, pair.Part), func(t *testing.T) {
+			solve := s.Part1
+			if pair.Part == 2 {
+				solve = s.Part2
+			}
+			answer, err := solve(context.Background(), pair.Input)
+			if errors.Is(err, solver.ErrNotUnlocked) {
+				t.Skip("not yet unlocked")
+			}
+			if err != nil {
+				t.Fatalf("Part%d(%s) unexpected error %v", pair.Part, pair.Name, err)
+			}
+			if result := answer.String(); result != pair.Answer {
+				t.Errorf("Part%d(%s) = %s, expected %s", pair.Part, pair.Name, result, pair.Answer)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package golden
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSaveLoad(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), Dir)
+	pairs := []Pair{
+		{Name: "example", Part: 1, Input: "1 2\n", Answer: "3"},
+		{Name: "example", Part: 2, Input: "1 2\n", Answer: "2"},
+		{Name: "example2", Part: 2, Input: "4 5\n", Answer: "20"},
+	}
+	if err := Save(dir, pairs); err != nil {
+		t.Fatalf("Save() unexpected error %v", err)
+	}
+	loaded, err := Load(dir)
+	if err != nil || !reflect.DeepEqual(loaded, pairs) {
+		t.Errorf("Load() = %v, %v, expected %v", loaded, err, pairs)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	dir := t.TempDir()
+	if pairs, err := Load(filepath.Join(dir, "missing")); err != nil || len(pairs) != 0 {
+		t.Errorf("Load() of a missing dir = %v, %v, expected no pairs", pairs, err)
+	}
+	os.WriteFile(filepath.Join(dir, "orphan.part1"), []byte("3\n"), 0o644)
+	if _, err := Load(dir); err == nil {
+		t.Errorf("Load() with an answer but no input expected an error")
+	}
+}
@@ -0,0 +1,254 @@
+// Package grid is a rectangular two dimensional grid of any cell type,
+// parsed from the text of a puzzle and rendered back to it
+package grid
+
+import (
+	"io"
+	"iter"
+	"strings"
+
+	"aoc2025/input"
+)
+
+// Point is the position of a cell, X is the column and Y the row,
+// both counting from 0 at the top left
+type Point struct {
+	X int
+	Y int
+}
+
+// Add returns p moved by d
+func (p Point) Add(d Point) Point {
+	return Point{X: p.X + d.X, Y: p.Y + d.Y}
+}
+
+// Orthogonal are the steps to the 4 neighbours sharing an edge, clockwise from up
+var Orthogonal = []Point{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+
+// Surrounding are the steps to the 8 neighbours sharing an edge or a corner,
+// clockwise from up
+var Surrounding = []Point{{0, -1}, {1, -1}, {1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}}
+
+// Grid is a width by height grid of cells stored row by row
+// a Grid shares its cells when copied, use Clone for an independent one
+type Grid[T any] struct {
+	width  int
+	height int
+	cells  []T
+}
+
+// New returns a width by height grid of zero cells
+func New[T any](width, height int) Grid[T] {
+	width, height = max(width, 0), max(height, 0)
+	return Grid[T]{width: width, height: height, cells: make([]T, width*height)}
+}
+
+// FromRows builds a grid from rows that may have different lengths
+// the grid is as wide as the longest row, shorter rows are padded with pad
+func FromRows[T any](rows [][]T, pad T) Grid[T] {
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+	g := New[T](width, len(rows))
+	for y, row := range rows {
+		line := g.cells[y*width : (y+1)*width]
+		n := copy(line, row)
+		for x := n; x < width; x++ {
+			line[x] = pad
+		}
+	}
+	return g
+}
+
+// Parse reads the non-blank lines of r as a grid, mapping every rune to a cell
+// lines shorter than the longest are padded with pad before they are mapped
+func Parse[T any](r io.Reader, pad rune, cell func(rune) T) (Grid[T], error) {
+	rows, err := input.Grid(r, input.Lenient)
+	if err != nil {
+		return Grid[T]{}, err
+	}
+	runes := FromRows(rows, pad)
+	g := New[T](runes.width, runes.height)
+	for i, c := range runes.cells {
+		g.cells[i] = cell(c)
+	}
+	return g, nil
+}
+
+// ParseRunes is Parse keeping the runes as they are
+func ParseRunes(r io.Reader, pad rune) (Grid[rune], error) {
+	return Parse(r, pad, func(c rune) rune { return c })
+}
+
+// Width is the number of columns
+func (g Grid[T]) Width() int {
+	return g.width
+}
+
+// Height is the number of rows
+func (g Grid[T]) Height() int {
+	return g.height
+}
+
+// In reports whether p is inside the grid
+func (g Grid[T]) In(p Point) bool {
+	return p.X >= 0 && p.X < g.width && p.Y >= 0 && p.Y < g.height
+}
+
+// Get returns the cell at p, ok is false when p is outside the grid
+func (g Grid[T]) Get(p Point) (cell T, ok bool) {
+	if !g.In(p) {
+		return cell, false
+	}
+	return g.cells[p.Y*g.width+p.X], true
+}
+
+// At returns the cell at p, or the zero value when p is outside the grid
+func (g Grid[T]) At(p Point) T {
+	cell, _ := g.Get(p)
+	return cell
+}
+
+// Set changes the cell at p, it reports false and does nothing when p is outside the grid
+func (g Grid[T]) Set(p Point, cell T) bool {
+	if !g.In(p) {
+		return false
+	}
+	g.cells[p.Y*g.width+p.X] = cell
+	return true
+}
+
+// All yields every cell with its position, row by row
+func (g Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, cell := range g.cells {
+			if !yield(Point{X: i % g.width, Y: i / g.width}, cell) {
+				return
+			}
+		}
+	}
+}
+
+// Find returns the position of the first cell, row by row, that match accepts
+func (g Grid[T]) Find(match func(T) bool) (Point, bool) {
+	for p, cell := range g.All() {
+		if match(cell) {
+			return p, true
+		}
+	}
+	return Point{}, false
+}
+
+// Neighbours yields the cells at p moved by every step that are inside the grid
+func (g Grid[T]) Neighbours(p Point, steps []Point) iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for _, step := range steps {
+			q := p.Add(step)
+			if cell, ok := g.Get(q); ok && !yield(q, cell) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbours4 yields the orthogonal neighbours of p inside the grid
+func (g Grid[T]) Neighbours4(p Point) iter.Seq2[Point, T] {
+	return g.Neighbours(p, Orthogonal)
+}
+
+// Neighbours8 yields the orthogonal and diagonal neighbours of p inside the grid
+func (g Grid[T]) Neighbours8(p Point) iter.Seq2[Point, T] {
+	return g.Neighbours(p, Surrounding)
+}
+
+// Row returns a copy of row y, nil when y is outside the grid
+func (g Grid[T]) Row(y int) []T {
+	if y < 0 || y >= g.height {
+		return nil
+	}
+	row := make([]T, g.width)
+	copy(row, g.cells[y*g.width:])
+	return row
+}
+
+// Column returns a copy of column x, nil when x is outside the grid
+func (g Grid[T]) Column(x int) []T {
+	if x < 0 || x >= g.width {
+		return nil
+	}
+	column := make([]T, g.height)
+	for y := range column {
+		column[y] = g.cells[y*g.width+x]
+	}
+	return column
+}
+
+// Rows returns a copy of rows from up to, but not including, to
+// the bounds are clamped to the grid
+func (g Grid[T]) Rows(from, to int) Grid[T] {
+	return g.Sub(0, from, g.width, to)
+}
+
+// Columns returns a copy of columns from up to, but not including, to
+// the bounds are clamped to the grid
+func (g Grid[T]) Columns(from, to int) Grid[T] {
+	return g.Sub(from, 0, to, g.height)
+}
+
+// Sub returns a copy of the cells from (x0, y0) up to, but not including, (x1, y1)
+// the bounds are clamped to the grid
+func (g Grid[T]) Sub(x0, y0, x1, y1 int) Grid[T] {
+	x0, x1 = min(max(x0, 0), g.width), min(max(x1, 0), g.width)
+	y0, y1 = min(max(y0, 0), g.height), min(max(y1, 0), g.height)
+	sub := New[T](x1-x0, y1-y0)
+	for y := y0; y < y1; y++ {
+		copy(sub.cells[(y-y0)*sub.width:(y-y0+1)*sub.width], g.cells[y*g.width+x0:y*g.width+x1])
+	}
+	return sub
+}
+
+// Clone returns a copy of g that does not share its cells
+func (g Grid[T]) Clone() Grid[T] {
+	return g.Sub(0, 0, g.width, g.height)
+}
+
+// Transpose returns g mirrored along its main diagonal, rows become columns
+func (g Grid[T]) Transpose() Grid[T] {
+	return g.remap(g.height, g.width, func(p Point) Point { return Point{X: p.Y, Y: p.X} })
+}
+
+// RotateClockwise returns g turned a quarter turn clockwise
+// the first column, read bottom up, becomes the first row
+func (g Grid[T]) RotateClockwise() Grid[T] {
+	return g.remap(g.height, g.width, func(p Point) Point { return Point{X: p.Y, Y: g.height - 1 - p.X} })
+}
+
+// RotateCounterClockwise returns g turned a quarter turn counter clockwise
+// the last column, read top down, becomes the first row
+func (g Grid[T]) RotateCounterClockwise() Grid[T] {
+	return g.remap(g.height, g.width, func(p Point) Point { return Point{X: g.width - 1 - p.Y, Y: p.X} })
+}
+
+// remap builds a width by height grid whose cell at p is the cell of g at from(p)
+func (g Grid[T]) remap(width, height int, from func(Point) Point) Grid[T] {
+	out := New[T](width, height)
+	for i := range out.cells {
+		out.cells[i] = g.At(from(Point{X: i % width, Y: i / width}))
+	}
+	return out
+}
+
+// Render writes every cell as the rune it maps to, one line per row
+func (g Grid[T]) Render(cell func(T) rune) string {
+	var b strings.Builder
+	for y := 0; y < g.height; y++ {
+		if y > 0 {
+			b.WriteByte('\n')
+		}
+		for _, c := range g.cells[y*g.width : (y+1)*g.width] {
+			b.WriteRune(cell(c))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,149 @@
+package grid
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func parse(t *testing.T, text string) Grid[rune] {
+	t.Helper()
+	g, err := ParseRunes(strings.NewReader(text), '.')
+	if err != nil {
+		t.Fatalf("ParseRunes(%q) error = %v", text, err)
+	}
+	return g
+}
+
+func render(g Grid[rune]) string {
+	return g.Render(func(c rune) rune { return c })
+}
+
+func TestParseRagged(t *testing.T) {
+	g := parse(t, "ab\nc\n\nabcd\n")
+	if g.Width() != 4 || g.Height() != 3 {
+		t.Errorf("ParseRunes() size = %dx%d, expected 4x3", g.Width(), g.Height())
+	}
+	if result, expected := render(g), "ab..\nc...\nabcd"; result != expected {
+		t.Errorf("Render() = %q, expected %q", result, expected)
+	}
+}
+
+func TestParseMapsCells(t *testing.T) {
+	g, err := Parse(strings.NewReader("@.\n.@@"), '.', func(c rune) bool { return c == '@' })
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if result := g.Render(func(b bool) rune { return map[bool]rune{true: '#', false: ' '}[b] }); result != "#  \n ##" {
+		t.Errorf("Render() = %q, expected %q", result, "#  \n ##")
+	}
+}
+
+func TestAccess(t *testing.T) {
+	g := parse(t, "ab\ncd")
+	tests := []struct {
+		p        Point
+		expected rune
+		ok       bool
+	}{
+		{Point{0, 0}, 'a', true},
+		{Point{1, 1}, 'd', true},
+		{Point{2, 0}, 0, false},
+		{Point{0, -1}, 0, false},
+	}
+	for _, test := range tests {
+		if cell, ok := g.Get(test.p); cell != test.expected || ok != test.ok {
+			t.Errorf("Get(%v) = %q, %v, expected %q, %v", test.p, cell, ok, test.expected, test.ok)
+		}
+		if cell := g.At(test.p); cell != test.expected {
+			t.Errorf("At(%v) = %q, expected %q", test.p, cell, test.expected)
+		}
+	}
+	if g.Set(Point{5, 5}, 'x') {
+		t.Errorf("Set() outside the grid = true, expected false")
+	}
+	g.Set(Point{1, 0}, 'x')
+	if result := render(g); result != "ax\ncd" {
+		t.Errorf("Render() after Set = %q, expected %q", result, "ax\ncd")
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	g := parse(t, "abc\ndef\nghi")
+	collect := func(seq func(func(Point, rune) bool)) string {
+		cells := []rune{}
+		for _, c := range seq {
+			cells = append(cells, c)
+		}
+		return string(cells)
+	}
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{"Neighbours4 centre", collect(g.Neighbours4(Point{1, 1})), "bfhd"},
+		{"Neighbours8 centre", collect(g.Neighbours8(Point{1, 1})), "bcfihgda"},
+		{"Neighbours4 corner", collect(g.Neighbours4(Point{0, 0})), "bd"},
+		{"Neighbours8 corner", collect(g.Neighbours8(Point{2, 2})), "fhe"},
+	}
+	for _, test := range tests {
+		if test.result != test.expected {
+			t.Errorf("%s = %q, expected %q", test.name, test.result, test.expected)
+		}
+	}
+}
+
+func TestSlicing(t *testing.T) {
+	g := parse(t, "abc\ndef")
+	if result := g.Row(1); !reflect.DeepEqual(result, []rune("def")) {
+		t.Errorf("Row(1) = %q, expected %q", result, "def")
+	}
+	if result := g.Column(2); !reflect.DeepEqual(result, []rune("cf")) {
+		t.Errorf("Column(2) = %q, expected %q", result, "cf")
+	}
+	if g.Row(2) != nil || g.Column(-1) != nil {
+		t.Errorf("Row or Column outside the grid expected nil")
+	}
+	if result := render(g.Columns(1, 5)); result != "bc\nef" {
+		t.Errorf("Columns(1, 5) = %q, expected %q", result, "bc\nef")
+	}
+	if result := render(g.Rows(1, 2)); result != "def" {
+		t.Errorf("Rows(1, 2) = %q, expected %q", result, "def")
+	}
+
+	clone := g.Clone()
+	clone.Set(Point{0, 0}, 'x')
+	if g.At(Point{0, 0}) != 'a' {
+		t.Errorf("Set on a Clone changed the original")
+	}
+}
+
+func TestTransformations(t *testing.T) {
+	g := parse(t, "abc\ndef")
+	tests := []struct {
+		name     string
+		result   Grid[rune]
+		expected string
+	}{
+		{"Transpose", g.Transpose(), "ad\nbe\ncf"},
+		{"RotateClockwise", g.RotateClockwise(), "da\neb\nfc"},
+		{"RotateCounterClockwise", g.RotateCounterClockwise(), "cf\nbe\nad"},
+		{"four turns", g.RotateClockwise().RotateClockwise().RotateClockwise().RotateClockwise(), "abc\ndef"},
+	}
+	for _, test := range tests {
+		if result := render(test.result); result != test.expected {
+			t.Errorf("%s = %q, expected %q", test.name, result, test.expected)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	g := parse(t, "...\n.S.")
+	if p, ok := g.Find(func(c rune) bool { return c == 'S' }); !ok || p != (Point{1, 1}) {
+		t.Errorf("Find(S) = %v, %v, expected {1 1}", p, ok)
+	}
+	if _, ok := g.Find(func(c rune) bool { return c == 'X' }); ok {
+		t.Errorf("Find(X) found a cell, expected none")
+	}
+}
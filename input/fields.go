@@ -0,0 +1,135 @@
+package input
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Int parses a decimal integer, surrounding spaces are allowed
+func Int(s string) (int, error) {
+	trimmed := strings.TrimLeft(s, " \t")
+	start := len(s) - len(trimmed) + 1
+	trimmed = strings.TrimRight(trimmed, " \t")
+	n, err := strconv.Atoi(trimmed)
+	if err != nil {
+		if numErr := (*strconv.NumError)(nil); errors.As(err, &numErr) {
+			err = numErr.Err
+		}
+		return 0, &Error{Column: start, Err: fmt.Errorf("%q is not an integer: %w", trimmed, err)}
+	}
+	return n, nil
+}
+
+// Ints parses a list of integers separated by sep, or by runs of spaces when sep is empty
+func Ints(s, sep string) ([]int, error) {
+	values := []int{}
+	for _, f := range fields(s, sep) {
+		n, err := Int(f.text)
+		if err != nil {
+			return nil, Offset(err, f.start)
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
+
+// Tuple parses exactly n comma separated integers, such as the 3,4,5 of a coordinate
+func Tuple(s string, n int) ([]int, error) {
+	values, err := Ints(s, ",")
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != n {
+		return nil, errorAt(1, "%q has %d values, expected %d", strings.TrimSpace(s), len(values), n)
+	}
+	return values, nil
+}
+
+// Range is an inclusive range of integers written as start-end
+type Range struct {
+	Start int
+	End   int
+}
+
+// ParseRange parses a range written as start-end, such as 11-22 or -5--3
+func ParseRange(s string) (Range, error) {
+	sep := rangeSeparator(s)
+	if sep < 0 || rangeSeparator(s[sep+1:]) >= 0 {
+		return Range{}, errorAt(1, "invalid range %q, expected start-end", strings.TrimSpace(s))
+	}
+	start, err := Int(s[:sep])
+	if err != nil {
+		return Range{}, err
+	}
+	end, err := Int(s[sep+1:])
+	if err != nil {
+		return Range{}, Offset(err, sep+1)
+	}
+	return Range{Start: start, End: end}, nil
+}
+
+// rangeSeparator is the index of the first - that ends a number, so the sign
+// of a negative start or end is not taken for it, or -1 when there is none
+func rangeSeparator(s string) int {
+	digit := false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '-' && digit:
+			return i
+		case s[i] >= '0' && s[i] <= '9':
+			digit = true
+		case s[i] != ' ' && s[i] != '\t':
+			digit = false
+		}
+	}
+	return -1
+}
+
+// Len is the number of integers in r, 0 when End is before Start
+func (r Range) Len() int {
+	return max(r.End-r.Start+1, 0)
+}
+
+// Contains reports whether x is in r, its ends included
+func (r Range) Contains(x int) bool {
+	return x >= r.Start && x <= r.End
+}
+
+func (r Range) String() string {
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
+
+// field is part of a text, start bytes in
+type field struct {
+	text  string
+	start int
+}
+
+// fields splits s at every sep, or at runs of spaces when sep is empty
+func fields(s, sep string) []field {
+	parts := []field{}
+	if sep == "" {
+		start := -1
+		for i := 0; i <= len(s); i++ {
+			space := i == len(s) || s[i] == ' ' || s[i] == '\t'
+			if space && start >= 0 {
+				parts = append(parts, field{text: s[start:i], start: start})
+				start = -1
+			} else if !space && start < 0 {
+				start = i
+			}
+		}
+		return parts
+	}
+	start := 0
+	for {
+		i := strings.Index(s[start:], sep)
+		if i < 0 {
+			return append(parts, field{text: s[start:], start: start})
+		}
+		parts = append(parts, field{text: s[start : start+i], start: start})
+		start += i + len(sep)
+	}
+}
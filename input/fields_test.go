@@ -0,0 +1,103 @@
+package input
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestInt(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+		column   int // of the error, 0 when it parses
+	}{
+		{"42", 42, 0},
+		{"  -7 ", -7, 0},
+		{"x", 0, 1},
+		{"   1x", 0, 4},
+		{"", 0, 1},
+	}
+	for _, test := range tests {
+		result, err := Int(test.input)
+		if test.column == 0 {
+			if err != nil || result != test.expected {
+				t.Errorf("Int(%q) = %d, %v, expected %d", test.input, result, err, test.expected)
+			}
+			continue
+		}
+		var posErr *Error
+		if !errors.As(err, &posErr) || posErr.Column != test.column {
+			t.Errorf("Int(%q) error = %v, expected an error at column %d", test.input, err, test.column)
+		}
+	}
+	if _, err := Int("x"); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Int(x) error = %v, expected it to wrap strconv.ErrSyntax", err)
+	}
+}
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		input    string
+		sep      string
+		expected []int
+	}{
+		{"1,2,3", ",", []int{1, 2, 3}},
+		{"1, 2 ,3", ",", []int{1, 2, 3}},
+		{"  12   34\t5 ", "", []int{12, 34, 5}},
+		{"", "", []int{}},
+	}
+	for _, test := range tests {
+		result, err := Ints(test.input, test.sep)
+		if err != nil || !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Ints(%q, %q) = %v, %v, expected %v", test.input, test.sep, result, err, test.expected)
+		}
+	}
+
+	_, err := Ints("1,2,x3", ",")
+	var posErr *Error
+	if !errors.As(err, &posErr) || posErr.Column != 5 {
+		t.Errorf("Ints(1,2,x3) error = %v, expected an error at column 5", err)
+	}
+}
+
+func TestTuple(t *testing.T) {
+	if result, err := Tuple("162,817,812", 3); err != nil || !reflect.DeepEqual(result, []int{162, 817, 812}) {
+		t.Errorf("Tuple(162,817,812, 3) = %v, %v, expected [162 817 812]", result, err)
+	}
+	for _, input := range []string{"1,2", "1,2,3,4", "1,,3"} {
+		if _, err := Tuple(input, 3); err == nil {
+			t.Errorf("Tuple(%q, 3) expected error, got nil", input)
+		}
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Range
+	}{
+		{"11-22", Range{11, 22}},
+		{" 3-5 ", Range{3, 5}},
+		{"998-1012", Range{998, 1012}},
+		{"-5--3", Range{-5, -3}},
+		{"-5-3", Range{-5, 3}},
+		{"3 - -5", Range{3, -5}},
+	}
+	for _, test := range tests {
+		result, err := ParseRange(test.input)
+		if err != nil || result != test.expected {
+			t.Errorf("ParseRange(%q) = %v, %v, expected %v", test.input, result, err, test.expected)
+		}
+	}
+
+	columns := map[string]int{"": 1, "11": 1, "1-2-3": 1, "11-x": 4, "a-5": 1, "-5--x": 4, "11-": 4}
+	for input, column := range columns {
+		_, err := ParseRange(input)
+		var posErr *Error
+		if !errors.As(err, &posErr) || posErr.Column != column {
+			t.Errorf("ParseRange(%q) error = %v, expected an error at column %d", input, err, column)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+// Package input parses puzzle inputs: lines, blank line separated blocks,
+// integer lists, a-b ranges, comma separated tuples and rune grids
+// errors carry the line and column they were found at, and a Lenient
+// scanner skips bad records instead of stopping at the first one
+package input
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"aoc2025/stream"
+)
+
+// Mode says what happens to a record that does not parse
+type Mode int
+
+const (
+	Strict  Mode = iota // the first bad record stops the scan and is returned
+	Lenient             // bad records are skipped and kept for Skipped
+)
+
+// Error is a parse error at a position in the input
+// Line and Column count from 1, and are 0 when not known
+// columns count bytes
+type Error struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *Error) Error() string {
+	switch {
+	case e.Line > 0 && e.Column > 0:
+		return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+	case e.Line > 0:
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	case e.Column > 0:
+		return fmt.Sprintf("column %d: %v", e.Column, e.Err)
+	default:
+		return e.Err.Error()
+	}
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// errorAt returns err at column, counting from 1, of the text it was found in
+func errorAt(column int, format string, args ...any) error {
+	return &Error{Column: column, Err: fmt.Errorf(format, args...)}
+}
+
+// Offset moves the column of err, found in a field, to the text the field
+// starts in, offset bytes in
+func Offset(err error, offset int) error {
+	posErr, ok := err.(*Error)
+	if !ok || posErr.Column == 0 {
+		return err
+	}
+	moved := *posErr
+	moved.Column += offset
+	return &moved
+}
+
+// Scanner reads records one at a time and keeps track of where each starts
+// a blank record ends a block, Scan stops there until NextBlock is called
+type Scanner struct {
+	scanner    *bufio.Scanner
+	sep        byte
+	mode       Mode
+	text       string
+	line       int // position of the current record
+	column     int
+	nextLine   int // position of the record after it
+	nextColumn int
+	blank      bool // stopped at a blank record
+	pending    bool // NextBlock has read the first record of the block already
+	err        error
+	skipped    []error
+}
+
+// NewScanner scans r line by line
+func NewScanner(r io.Reader, mode Mode) *Scanner {
+	return &Scanner{scanner: stream.Lines(r), sep: '\n', mode: mode, nextLine: 1, nextColumn: 1}
+}
+
+// NewRecordScanner scans r as records separated by sep, such as a comma
+// separated list, possibly spread over several lines
+func NewRecordScanner(r io.Reader, sep byte, mode Mode) *Scanner {
+	return &Scanner{scanner: stream.Separated(r, sep), sep: sep, mode: mode, nextLine: 1, nextColumn: 1}
+}
+
+// Scan moves to the next record of the current block
+// it returns false at the blank record ending the block, at the end of the
+// input, and after an error
+func (s *Scanner) Scan() bool {
+	if s.pending {
+		s.pending = false
+		return true
+	}
+	if s.blank || s.err != nil || !s.scanner.Scan() {
+		return false
+	}
+	// a record of a separated list can start on the line after a separator
+	raw := s.scanner.Text()
+	text := strings.TrimLeft(raw, "\r\n")
+	s.advance(raw[:len(raw)-len(text)])
+	s.line, s.column = s.nextLine, s.nextColumn
+	s.advance(text)
+	s.separator()
+	s.text = strings.TrimRight(text, "\r\n")
+	if strings.TrimSpace(s.text) == "" {
+		s.blank = true
+		return false
+	}
+	return true
+}
+
+// advance moves the position past text
+func (s *Scanner) advance(text string) {
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			s.nextLine, s.nextColumn = s.nextLine+1, 1
+		} else {
+			s.nextColumn++
+		}
+	}
+}
+
+// separator moves the position past the separator after a record
+func (s *Scanner) separator() {
+	if s.sep == '\n' {
+		s.nextLine, s.nextColumn = s.nextLine+1, 1
+	} else {
+		s.nextColumn++
+	}
+}
+
+// NextBlock skips the rest of the current block and any blank records after it
+// it returns false when there is no block left
+func (s *Scanner) NextBlock() bool {
+	for s.Scan() {
+	}
+	for s.blank {
+		s.blank = false
+		if s.Scan() {
+			s.pending = true
+			return true
+		}
+	}
+	return false
+}
+
+// Text is the current record without line endings around it
+func (s *Scanner) Text() string {
+	return s.text
+}
+
+// Line is the line the current record starts on
+func (s *Scanner) Line() int {
+	return s.line
+}
+
+// Column is the column the current record starts at
+func (s *Scanner) Column() int {
+	return s.column
+}
+
+// Reject reports that the current record did not parse
+// err is placed at the record, columns of an *Error counting from its start
+// a Strict scanner stops and returns the placed error, a Lenient one keeps
+// it for Skipped and returns nil so the caller can carry on
+func (s *Scanner) Reject(err error) error {
+	err = s.place(err)
+	if s.mode == Lenient {
+		s.skipped = append(s.skipped, err)
+		return nil
+	}
+	s.err = err
+	return err
+}
+
+// place puts err at the current record, a line scanner only gives the
+// record's column when err has one of its own
+func (s *Scanner) place(err error) error {
+	placed, ok := err.(*Error)
+	if ok {
+		copied := *placed
+		placed = &copied
+	} else {
+		placed = &Error{Err: err}
+	}
+	placed.Line = s.line
+	if placed.Column > 0 {
+		placed.Column += s.column - 1
+	} else if s.sep != '\n' {
+		placed.Column = s.column
+	}
+	return placed
+}
+
+// Skipped returns the errors of the records a Lenient scanner skipped
+func (s *Scanner) Skipped() []error {
+	return s.skipped
+}
+
+// Err returns the error that stopped the scan, if any
+func (s *Scanner) Err() error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.scanner.Err()
+}
+
+// Block parses every record of the scanner's current block and hands the values to f
+func Block[T any](s *Scanner, parse func(string) (T, error), f func(T)) error {
+	for s.Scan() {
+		value, err := parse(s.Text())
+		if err != nil {
+			if err := s.Reject(err); err != nil {
+				return err
+			}
+			continue
+		}
+		f(value)
+	}
+	return s.Err()
+}
+
+// Each is Block for every block, blank records are skipped
+func Each[T any](s *Scanner, parse func(string) (T, error), f func(T)) error {
+	for more := true; more; more = s.NextBlock() {
+		if err := Block(s, parse, f); err != nil {
+			return err
+		}
+	}
+	return s.Err()
+}
+
+// Parse parses every non-blank line of r
+func Parse[T any](r io.Reader, mode Mode, parse func(string) (T, error)) ([]T, error) {
+	values := []T{}
+	err := Each(NewScanner(r, mode), parse, func(value T) {
+		values = append(values, value)
+	})
+	return values, err
+}
+
+// Lines returns every non-blank line of r
+func Lines(r io.Reader) ([]string, error) {
+	return Parse(r, Strict, func(line string) (string, error) { return line, nil })
+}
+
+// Blocks returns the lines of every block of r, blocks are separated by blank lines
+func Blocks(r io.Reader) ([][]string, error) {
+	blocks := [][]string{}
+	s := NewScanner(r, Strict)
+	for more := true; more; more = s.NextBlock() {
+		block := []string{}
+		for s.Scan() {
+			block = append(block, s.Text())
+		}
+		if len(block) > 0 {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks, s.Err()
+}
+
+// Grid reads the non-blank lines of r as rows of runes
+// a Strict grid must be rectangular, a Lenient one may have ragged rows
+func Grid(r io.Reader, mode Mode) ([][]rune, error) {
+	rows := [][]rune{}
+	err := Each(NewScanner(r, mode), func(line string) ([]rune, error) {
+		row := []rune(line)
+		if mode == Strict && len(rows) > 0 && len(row) != len(rows[0]) {
+			short := min(len(row), len(rows[0]))
+			return nil, errorAt(len(string(row[:short]))+1, "row has %d runes, expected %d", len(row), len(rows[0]))
+		}
+		return row, nil
+	}, func(row []rune) {
+		rows = append(rows, row)
+	})
+	return rows, err
+}
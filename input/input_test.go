@@ -0,0 +1,117 @@
+package input
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestErrorString(t *testing.T) {
+	err := errors.New("bad")
+	tests := []struct {
+		err      *Error
+		expected string
+	}{
+		{&Error{Line: 3, Column: 5, Err: err}, "line 3, column 5: bad"},
+		{&Error{Line: 3, Err: err}, "line 3: bad"},
+		{&Error{Column: 5, Err: err}, "column 5: bad"},
+		{&Error{Err: err}, "bad"},
+	}
+	for _, test := range tests {
+		if result := test.err.Error(); result != test.expected {
+			t.Errorf("Error() = %q, expected %q", result, test.expected)
+		}
+	}
+}
+
+func TestLinesAndBlocks(t *testing.T) {
+	const text = "a\r\nb\n\n\nc\n\nd\n"
+	lines, err := Lines(strings.NewReader(text))
+	if expected := []string{"a", "b", "c", "d"}; err != nil || !reflect.DeepEqual(lines, expected) {
+		t.Errorf("Lines() = %q, %v, expected %q", lines, err, expected)
+	}
+	blocks, err := Blocks(strings.NewReader(text))
+	if expected := [][]string{{"a", "b"}, {"c"}, {"d"}}; err != nil || !reflect.DeepEqual(blocks, expected) {
+		t.Errorf("Blocks() = %q, %v, expected %q", blocks, err, expected)
+	}
+}
+
+func TestScannerBlocks(t *testing.T) {
+	s := NewScanner(strings.NewReader("3-5\n10-14\n\n1\n5\n"), Strict)
+	ranges := []Range{}
+	if err := Block(s, ParseRange, func(r Range) { ranges = append(ranges, r) }); err != nil {
+		t.Fatalf("Block() ranges error = %v", err)
+	}
+	if !s.NextBlock() {
+		t.Fatalf("NextBlock() = false, expected the ids block")
+	}
+	ids := []int{}
+	if err := Block(s, Int, func(id int) { ids = append(ids, id) }); err != nil {
+		t.Fatalf("Block() ids error = %v", err)
+	}
+	if !reflect.DeepEqual(ranges, []Range{{3, 5}, {10, 14}}) || !reflect.DeepEqual(ids, []int{1, 5}) {
+		t.Errorf("Block() read %v and %v, expected [3-5 10-14] and [1 5]", ranges, ids)
+	}
+	if s.NextBlock() {
+		t.Errorf("NextBlock() = true at the end of the input")
+	}
+}
+
+func TestStrictAndLenient(t *testing.T) {
+	const text = "1,2\n3,x\n\n5,6\n7\n"
+	parse := func(line string) ([]int, error) { return Tuple(line, 2) }
+
+	_, err := Parse(strings.NewReader(text), Strict, parse)
+	var posErr *Error
+	if !errors.As(err, &posErr) || posErr.Line != 2 || posErr.Column != 3 {
+		t.Errorf("Parse(Strict) error = %v, expected line 2, column 3", err)
+	}
+
+	s := NewScanner(strings.NewReader(text), Lenient)
+	values := [][]int{}
+	if err := Each(s, parse, func(v []int) { values = append(values, v) }); err != nil {
+		t.Fatalf("Each(Lenient) error = %v", err)
+	}
+	if expected := [][]int{{1, 2}, {5, 6}}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("Each(Lenient) = %v, expected %v", values, expected)
+	}
+	lines := []int{}
+	for _, err := range s.Skipped() {
+		if errors.As(err, &posErr) {
+			lines = append(lines, posErr.Line)
+		}
+	}
+	if !reflect.DeepEqual(lines, []int{2, 5}) {
+		t.Errorf("Skipped() lines = %v, expected [2 5]", lines)
+	}
+}
+
+func TestRecordScanner(t *testing.T) {
+	s := NewRecordScanner(strings.NewReader("11-22,95-115,\n998-x\n"), ',', Strict)
+	ranges := []Range{}
+	err := Each(s, ParseRange, func(r Range) { ranges = append(ranges, r) })
+	var posErr *Error
+	if !errors.As(err, &posErr) || posErr.Line != 2 || posErr.Column != 5 {
+		t.Errorf("Each() error = %v, expected line 2, column 5", err)
+	}
+	if !reflect.DeepEqual(ranges, []Range{{11, 22}, {95, 115}}) {
+		t.Errorf("Each() = %v, expected the ranges before the error", ranges)
+	}
+}
+
+func TestGrid(t *testing.T) {
+	grid, err := Grid(strings.NewReader("ab\ncd\n"), Strict)
+	if expected := [][]rune{[]rune("ab"), []rune("cd")}; err != nil || !reflect.DeepEqual(grid, expected) {
+		t.Errorf("Grid() = %q, %v, expected %q", grid, err, expected)
+	}
+
+	_, err = Grid(strings.NewReader("abc\nd\n"), Strict)
+	var posErr *Error
+	if !errors.As(err, &posErr) || posErr.Line != 2 || posErr.Column != 2 {
+		t.Errorf("Grid(Strict) of ragged rows error = %v, expected line 2, column 2", err)
+	}
+	if grid, err := Grid(strings.NewReader("abc\nd\n"), Lenient); err != nil || len(grid) != 2 || len(grid[1]) != 1 {
+		t.Errorf("Grid(Lenient) = %q, %v, expected the ragged rows", grid, err)
+	}
+}
@@ -0,0 +1,258 @@
+// Package intervals is sets of integers stored as sorted, disjoint ranges
+// a Set is immutable, every operation returns a new one
+package intervals
+
+import (
+	"cmp"
+	"io"
+	"iter"
+	"slices"
+	"strings"
+
+	"aoc2025/input"
+)
+
+// Range is an inclusive range of integers written as start-end, such as 3-5 or -5--3
+type Range = input.Range
+
+// Set is a set of integers kept as sorted ranges that neither overlap nor touch,
+// so equal sets have equal ranges
+// a Set also keeps the ranges it was made from, for Containing
+// the zero Set is empty
+type Set struct {
+	ranges  []Range
+	sources []Range // sorted by Start
+	reach   []int   // reach[i] is the largest End of sources[:i+1]
+}
+
+// Of returns the set of every integer in any of ranges
+// ranges may overlap or be out of order, empty ones are dropped
+func Of(ranges ...Range) Set {
+	sources := make([]Range, 0, len(ranges))
+	for _, r := range ranges {
+		if r.Start <= r.End {
+			sources = append(sources, r)
+		}
+	}
+	slices.SortStableFunc(sources, func(a, b Range) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	s := Set{sources: sources, reach: make([]int, len(sources))}
+	for i, r := range sources {
+		if n := len(s.ranges); n > 0 && touches(s.ranges[n-1], r) {
+			s.ranges[n-1].End = max(s.ranges[n-1].End, r.End)
+		} else {
+			s.ranges = append(s.ranges, r)
+		}
+		s.reach[i] = r.End
+		if i > 0 {
+			s.reach[i] = max(s.reach[i-1], r.End)
+		}
+	}
+	return s
+}
+
+// normalised returns the set of ranges that are already sorted, disjoint and
+// not touching, they are also its sources
+func normalised(ranges []Range) Set {
+	reach := make([]int, len(ranges))
+	for i, r := range ranges {
+		reach[i] = r.End
+	}
+	return Set{ranges: ranges, sources: ranges, reach: reach}
+}
+
+// touches reports whether r, starting no earlier than last, overlaps last or
+// starts right after it, written so the ends of int do not overflow
+func touches(last, r Range) bool {
+	return r.Start <= last.End || r.Start-1 == last.End
+}
+
+// Read reads a set written as comma separated ranges, such as 3-5,10-20
+// the list may be spread over several lines, errors carry their position
+func Read(r io.Reader) (Set, error) {
+	ranges := []Range{}
+	err := input.Each(input.NewRecordScanner(r, ',', input.Strict), input.ParseRange, func(r Range) {
+		ranges = append(ranges, r)
+	})
+	if err != nil {
+		return Set{}, err
+	}
+	return Of(ranges...), nil
+}
+
+// Parse is Read for a string, it reads what String writes
+func Parse(s string) (Set, error) {
+	return Read(strings.NewReader(s))
+}
+
+// String writes the ranges of s comma separated, such as 3-5,10-20
+func (s Set) String() string {
+	parts := make([]string, len(s.ranges))
+	for i, r := range s.ranges {
+		parts[i] = r.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+// Ranges returns a copy of the ranges of s, in order
+func (s Set) Ranges() []Range {
+	return slices.Clone(s.ranges)
+}
+
+// All yields the ranges of s in order
+func (s Set) All() iter.Seq[Range] {
+	return slices.Values(s.ranges)
+}
+
+// Values yields every integer in s in order
+func (s Set) Values() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, r := range s.ranges {
+			for x := r.Start; ; x++ {
+				if !yield(x) {
+					return
+				}
+				if x == r.End {
+					break
+				}
+			}
+		}
+	}
+}
+
+// Len is the number of ranges in s
+func (s Set) Len() int {
+	return len(s.ranges)
+}
+
+// IsEmpty reports whether s has no integers
+func (s Set) IsEmpty() bool {
+	return len(s.ranges) == 0
+}
+
+// Span is the number of integers in s
+func (s Set) Span() int {
+	total := 0
+	for _, r := range s.ranges {
+		total += r.Len()
+	}
+	return total
+}
+
+// Bounds is the smallest range holding all of s, ok is false when s is empty
+func (s Set) Bounds() (r Range, ok bool) {
+	if s.IsEmpty() {
+		return r, false
+	}
+	return Range{Start: s.ranges[0].Start, End: s.ranges[len(s.ranges)-1].End}, true
+}
+
+// Find returns the range of s containing x, by binary search
+// it is the merged range, Containing gives the ranges s was made from
+func (s Set) Find(x int) (Range, bool) {
+	// the first range ending at or after x is the only one that can hold it
+	i, _ := slices.BinarySearchFunc(s.ranges, x, func(r Range, x int) int {
+		switch {
+		case r.End < x:
+			return -1
+		case r.End > x:
+			return 1
+		default:
+			return 0
+		}
+	})
+	if i < len(s.ranges) && s.ranges[i].Contains(x) {
+		return s.ranges[i], true
+	}
+	return Range{}, false
+}
+
+// Contains reports whether x is in s
+func (s Set) Contains(x int) bool {
+	_, ok := s.Find(x)
+	return ok
+}
+
+// Containing returns the ranges s was made from that contain x, by Start
+// it takes O(log n) to find the last range starting at or before x, then only
+// walks back over ranges that reach x
+func (s Set) Containing(x int) []Range {
+	i, found := slices.BinarySearchFunc(s.sources, x, func(r Range, x int) int {
+		return cmp.Compare(r.Start, x)
+	})
+	// step past every range starting at x
+	for found && i < len(s.sources) && s.sources[i].Start == x {
+		i++
+	}
+	containing := []Range{}
+	for j := i - 1; j >= 0 && s.reach[j] >= x; j-- {
+		if s.sources[j].End >= x {
+			containing = append(containing, s.sources[j])
+		}
+	}
+	slices.Reverse(containing)
+	return containing
+}
+
+// Equal reports whether s and other hold the same integers
+func (s Set) Equal(other Set) bool {
+	return slices.Equal(s.ranges, other.ranges)
+}
+
+// Union is the set of integers in s or other, made from the ranges of both
+func (s Set) Union(other Set) Set {
+	return Of(append(slices.Clone(s.sources), other.sources...)...)
+}
+
+// Intersect is the set of integers in both s and other
+// it, Difference and Complement are made from their own ranges
+func (s Set) Intersect(other Set) Set {
+	out := []Range{}
+	for i, j := 0, 0; i < len(s.ranges) && j < len(other.ranges); {
+		a, b := s.ranges[i], other.ranges[j]
+		if start, end := max(a.Start, b.Start), min(a.End, b.End); start <= end {
+			out = append(out, Range{Start: start, End: end})
+		}
+		// the range ending first cannot overlap anything further on
+		if a.End < b.End {
+			i++
+		} else {
+			j++
+		}
+	}
+	return normalised(out)
+}
+
+// Difference is the set of integers in s but not in other
+func (s Set) Difference(other Set) Set {
+	out := []Range{}
+	j := 0
+	for _, r := range s.ranges {
+		// skip the ranges of other that end before r
+		for j < len(other.ranges) && other.ranges[j].End < r.Start {
+			j++
+		}
+		start, covered := r.Start, false
+		for k := j; k < len(other.ranges) && other.ranges[k].Start <= r.End; k++ {
+			cut := other.ranges[k]
+			if cut.Start > start {
+				out = append(out, Range{Start: start, End: cut.Start - 1})
+			}
+			if cut.End >= r.End {
+				covered = true
+				break
+			}
+			start = cut.End + 1
+		}
+		if !covered {
+			out = append(out, Range{Start: start, End: r.End})
+		}
+	}
+	return normalised(out)
+}
+
+// Complement is the set of integers in within but not in s
+func (s Set) Complement(within Range) Set {
+	return Of(within).Difference(s)
+}
@@ -0,0 +1,208 @@
+package intervals
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"aoc2025/input"
+)
+
+// set parses s or fails the test
+func set(t *testing.T, s string) Set {
+	t.Helper()
+	result, err := Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q) unexpected error %v", s, err)
+	}
+	return result
+}
+
+func TestOf(t *testing.T) {
+	tests := []struct {
+		ranges   []Range
+		expected string
+	}{
+		{[]Range{{Start: 3, End: 5}, {Start: 10, End: 14}, {Start: 16, End: 20}, {Start: 12, End: 18}}, "3-5,10-20"},
+		{[]Range{{Start: 6, End: 8}, {Start: 3, End: 5}}, "3-8"},
+		{[]Range{{Start: 3, End: 5}, {Start: 4, End: 4}, {Start: 1, End: 9}}, "1-9"},
+		{[]Range{{Start: 5, End: 3}, {Start: 7, End: 7}}, "7-7"},
+		{nil, ""},
+	}
+	for _, test := range tests {
+		if result := Of(test.ranges...).String(); result != test.expected {
+			t.Errorf("Of(%v) = %q, expected %q", test.ranges, result, test.expected)
+		}
+	}
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	for _, s := range []string{"", "3-5", "3-5,10-20", "0-0,2-2,4-9", "-5--3,-1-2"} {
+		if result := set(t, s).String(); result != s {
+			t.Errorf("Parse(%q).String() = %q, expected %q", s, result, s)
+		}
+	}
+	if result := set(t, "10-14,\n3-5,12-20").String(); result != "3-5,10-20" {
+		t.Errorf("Parse() of unsorted ranges = %q, expected %q", result, "3-5,10-20")
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		r      Range
+		length int
+		text   string
+	}{
+		{Range{Start: 3, End: 5}, 3, "3-5"},
+		{Range{Start: -5, End: -3}, 3, "-5--3"},
+		{Range{Start: 5, End: 3}, 0, "5-3"},
+	}
+	for _, test := range tests {
+		if result := test.r.Len(); result != test.length {
+			t.Errorf("%v.Len() = %d, expected %d", test.r, result, test.length)
+		}
+		if result, err := input.ParseRange(test.r.String()); err != nil || result != test.r {
+			t.Errorf("input.ParseRange(%q) = %v, %v, expected %v", test.r.String(), result, err, test.r)
+		}
+	}
+	if r := (Range{Start: -5, End: -3}); !r.Contains(-4) || r.Contains(4) {
+		t.Errorf("%v.Contains() does not match its integers", r)
+	}
+}
+
+func TestParseReportsPosition(t *testing.T) {
+	_, err := Parse("3-5,1x-2")
+	var posErr *input.Error
+	if !errors.As(err, &posErr) || posErr.Line != 1 || posErr.Column != 5 {
+		t.Errorf("Parse() error = %v, expected line 1, column 5", err)
+	}
+}
+
+func TestFind(t *testing.T) {
+	s := Of(Range{Start: 3, End: 5}, Range{Start: 10, End: 20}, Range{Start: 30, End: 30})
+	tests := []struct {
+		x        int
+		expected Range
+		ok       bool
+	}{
+		{2, Range{}, false},
+		{3, Range{Start: 3, End: 5}, true},
+		{5, Range{Start: 3, End: 5}, true},
+		{6, Range{}, false},
+		{15, Range{Start: 10, End: 20}, true},
+		{30, Range{Start: 30, End: 30}, true},
+		{31, Range{}, false},
+	}
+	for _, test := range tests {
+		result, ok := s.Find(test.x)
+		if result != test.expected || ok != test.ok {
+			t.Errorf("%v.Find(%d) = %v, %v, expected %v, %v", s, test.x, result, ok, test.expected, test.ok)
+		}
+		if s.Contains(test.x) != test.ok {
+			t.Errorf("%v.Contains(%d) = %v, expected %v", s, test.x, !test.ok, test.ok)
+		}
+	}
+	if _, ok := (Set{}).Find(0); ok {
+		t.Errorf("Set{}.Find(0) found a range in an empty set")
+	}
+}
+
+func TestContaining(t *testing.T) {
+	s := Of(Range{Start: 10, End: 14}, Range{Start: 3, End: 5}, Range{Start: 12, End: 18}, Range{Start: 16, End: 20}, Range{Start: 1, End: 30})
+	tests := []struct {
+		x        int
+		expected []Range
+	}{
+		{0, []Range{}},
+		{3, []Range{{Start: 1, End: 30}, {Start: 3, End: 5}}},
+		{12, []Range{{Start: 1, End: 30}, {Start: 10, End: 14}, {Start: 12, End: 18}}},
+		{16, []Range{{Start: 1, End: 30}, {Start: 12, End: 18}, {Start: 16, End: 20}}},
+		{25, []Range{{Start: 1, End: 30}}},
+		{31, []Range{}},
+	}
+	for _, test := range tests {
+		if result := s.Containing(test.x); !slices.Equal(result, test.expected) {
+			t.Errorf("Containing(%d) = %v, expected %v", test.x, result, test.expected)
+		}
+	}
+	union := Of(Range{Start: 3, End: 5}).Union(Of(Range{Start: 4, End: 8}))
+	if result, expected := union.Containing(4), []Range{{Start: 3, End: 5}, {Start: 4, End: 8}}; !slices.Equal(result, expected) {
+		t.Errorf("Union().Containing(4) = %v, expected %v", result, expected)
+	}
+}
+
+func TestOperations(t *testing.T) {
+	tests := []struct {
+		a, b         string
+		union        string
+		intersect    string
+		difference   string
+		differenceBA string
+	}{
+		{"1-5", "3-8", "1-8", "3-5", "1-2", "6-8"},
+		{"1-2,8-9", "4-5", "1-2,4-5,8-9", "", "1-2,8-9", "4-5"},
+		{"1-10", "2-3,5-5,9-12", "1-12", "2-3,5-5,9-10", "1-1,4-4,6-8", "11-12"},
+		{"1-3", "4-6", "1-6", "", "1-3", "4-6"},
+		{"", "1-3", "1-3", "", "", "1-3"},
+	}
+	for _, test := range tests {
+		a, b := set(t, test.a), set(t, test.b)
+		if result := a.Union(b).String(); result != test.union {
+			t.Errorf("%q.Union(%q) = %q, expected %q", test.a, test.b, result, test.union)
+		}
+		if result := a.Intersect(b).String(); result != test.intersect {
+			t.Errorf("%q.Intersect(%q) = %q, expected %q", test.a, test.b, result, test.intersect)
+		}
+		if result := a.Difference(b).String(); result != test.difference {
+			t.Errorf("%q.Difference(%q) = %q, expected %q", test.a, test.b, result, test.difference)
+		}
+		if result := b.Difference(a).String(); result != test.differenceBA {
+			t.Errorf("%q.Difference(%q) = %q, expected %q", test.b, test.a, result, test.differenceBA)
+		}
+	}
+}
+
+func TestComplement(t *testing.T) {
+	s := set(t, "3-5,10-20")
+	tests := []struct {
+		within   Range
+		expected string
+	}{
+		{Range{Start: 0, End: 25}, "0-2,6-9,21-25"},
+		{Range{Start: 4, End: 12}, "6-9"},
+		{Range{Start: 10, End: 20}, ""},
+	}
+	for _, test := range tests {
+		if result := s.Complement(test.within).String(); result != test.expected {
+			t.Errorf("%v.Complement(%v) = %q, expected %q", s, test.within, result, test.expected)
+		}
+	}
+	all := Range{Start: math.MinInt, End: math.MaxInt}
+	if result := Of(all).Complement(all); !result.IsEmpty() {
+		t.Errorf("Complement() of every int = %v, expected an empty set", result)
+	}
+	if result := Of(Range{Start: math.MaxInt, End: math.MaxInt}).Complement(Range{Start: math.MaxInt - 2, End: math.MaxInt}); result.Span() != 2 {
+		t.Errorf("Complement() near the end of int = %v, expected 2 values", result.Ranges())
+	}
+}
+
+func TestSpanAndIteration(t *testing.T) {
+	s := set(t, "3-5,10-14,16-20,12-18")
+	if result := s.Span(); result != 14 {
+		t.Errorf("%v.Span() = %d, expected 14", s, result)
+	}
+	if result, ok := s.Bounds(); !ok || result != (Range{Start: 3, End: 20}) {
+		t.Errorf("%v.Bounds() = %v, %v, expected 3-20, true", s, result, ok)
+	}
+	if result := slices.Collect(s.All()); !slices.Equal(result, []Range{{Start: 3, End: 5}, {Start: 10, End: 20}}) {
+		t.Errorf("%v.All() = %v, expected [3-5 10-20]", s, result)
+	}
+	values := slices.Collect(set(t, "1-3,7-8").Values())
+	if expected := []int{1, 2, 3, 7, 8}; !slices.Equal(values, expected) {
+		t.Errorf("Values() = %v, expected %v", values, expected)
+	}
+	if !s.Equal(set(t, "10-20,3-5")) || s.Equal(set(t, "3-5")) {
+		t.Errorf("Equal() does not compare the integers of %v", s)
+	}
+}
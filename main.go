@@ -1,74 +1,148 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
 	"os"
-	"strconv"
+	"strings"
 
-	"aoc2025/day1"
-	"aoc2025/day10"
-	"aoc2025/day2"
-	"aoc2025/day3"
-	"aoc2025/day4"
-	"aoc2025/day5"
-	"aoc2025/day6"
-	"aoc2025/day7"
-	"aoc2025/day8"
-	"aoc2025/day9"
+	"aoc2025/registry"
+	"aoc2025/runner"
 )
 
-var days = map[int]Day{
-	1:  day1.Day1{},
-	2:  day2.Day2{},
-	3:  day3.Day3{},
-	4:  day4.Day4{},
-	5:  day5.Day5{},
-	6:  day6.Day6{},
-	7:  day7.Day7{},
-	8:  day8.Day8{},
-	9:  day9.Day9{},
-	10: day10.Day10{},
+// year is the Advent of Code event this repository solves
+const year = 2025
+
+// lastDay is the final puzzle of the event
+const lastDay = 12
+
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) int
 }
 
-func main() {
-	var daysToRun []int
+// commands in the order they are listed in the help text
+var commands = []command{
+	{"run", "run days and print their answers", runCommand},
+	{"bench", "time days over several runs", benchCommand},
+	{"verify", "check answers against the known answers", verifyCommand},
+	{"list", "list the registered days", listCommand},
+	{"serve", "serve the days over HTTP", serveCommand},
+	{"new", "create a new day package", newCommand},
+	{"examples", "extract examples from a saved puzzle page", examplesCommand},
+	{"fetch", "download puzzle inputs", fetchCommand},
+	{"submit", "submit the answer to a part", submitCommand},
+	{"encrypt", "encrypt puzzle inputs for committing", encryptCommand},
+	{"decrypt", "decrypt puzzle inputs", decryptCommand},
+	{"rekey", "re-encrypt puzzle inputs with a new key", rekeyCommand},
+}
 
-	if len(os.Args) > 1 {
-		dayNumber, err := strconv.Atoi(os.Args[1])
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Invalid day number: %s\n", os.Args[1])
+func main() {
+	if err := registry.Check(); err != nil {
+		fmt.Fprintf(os.Stderr, "Registry problems:\n%v\n", err)
+		if errors.Is(err, registry.ErrDuplicate) {
 			os.Exit(1)
 		}
-		daysToRun = []int{dayNumber}
-	} else {
-		for dayNum := range days {
-			daysToRun = append(daysToRun, dayNum)
+	}
+	os.Exit(dispatch(os.Args[1:]))
+}
+
+func dispatch(args []string) int {
+	if len(args) == 0 {
+		return runCommand(nil)
+	}
+	name := args[0]
+	switch name {
+	case "help", "-h", "-help", "--help":
+		if len(args) > 1 {
+			if cmd, ok := findCommand(args[1]); ok {
+				return cmd.run([]string{"-h"})
+			}
 		}
+		usage()
+		return 0
+	}
+	if cmd, ok := findCommand(name); ok {
+		return cmd.run(args[1:])
 	}
+	// "aoc2025 5" and "aoc2025 3-7" are shorthand for run
+	if name[0] >= '0' && name[0] <= '9' {
+		return runCommand(args)
+	}
+	fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", name)
+	usage()
+	return 2
+}
 
-	for _, dayNumber := range daysToRun {
-		day, exists := days[dayNumber]
-		if !exists {
-			fmt.Fprintf(os.Stderr, "Day %d not implemented yet\n", dayNumber)
-			continue
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
 		}
+	}
+	return command{}, false
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: aoc2025 <command> [flags] [DAYS]\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintf(os.Stderr, "\nDAYS is a list of days and ranges such as 3-7 or 1,4,9, all days by default.\n")
+	fmt.Fprintf(os.Stderr, "Run 'aoc2025 help <command>' for the flags of a command.\n")
+}
+
+// newFlagSet makes a flag set with a help text for a command
+func newFlagSet(name, arguments, description string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: aoc2025 %s %s\n\n%s\n\nFlags:\n", name, arguments, description)
+		fs.PrintDefaults()
+	}
+	return fs
+}
 
-		dataFile := fmt.Sprintf("day%d/data.txt", dayNumber)
-		data, err := os.ReadFile(dataFile)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error reading file %s: %v\n", dataFile, err)
-			continue
+// parseFlags parses a command's flags, returning the exit code to use
+// if the command should stop
+func parseFlags(fs *flag.FlagSet, args []string) (int, bool) {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0, false
 		}
+		return 2, false
+	}
+	return 0, true
+}
 
-		fmt.Printf("Day %d:\n", dayNumber)
-		part1 := day.Part1(string(data))
-		fmt.Printf("  Part 1: %v\n", part1)
+// profileFlags adds the profiling flags shared by run and bench
+// inputs other than the default set add their name to the files, dayN-partP-SET
+// a part that times out keeps running in the background and skews later CPU profiles
+func profileFlags(fs *flag.FlagSet) *runner.Profile {
+	profile := &runner.Profile{}
+	fs.StringVar(&profile.CPUDir, "cpuprofile", "", "write a CPU profile per day and part to `dir`/dayN-partP.cpu.pprof")
+	fs.StringVar(&profile.MemDir, "memprofile", "", "write a heap profile per day and part to `dir`/dayN-partP.mem.pprof")
+	fs.StringVar(&profile.TraceDir, "trace", "", "write an execution trace per day and part to `dir`/dayN-partP.trace")
+	return profile
+}
 
-		if part2, unlocked := day.Part2(string(data)); unlocked {
-			fmt.Printf("  Part 2: %v\n", part2)
-		} else {
-			fmt.Printf("  Part 2: Not yet unlocked\n")
+// selectDays resolves a list of day arguments against the registry
+func selectDays(args []string) ([]registry.Entry, error) {
+	available := []int{}
+	for _, entry := range registry.All() {
+		if entry.Year == year {
+			available = append(available, entry.Day)
 		}
-		fmt.Println()
 	}
+	dayNumbers, err := runner.ParseDays(strings.Join(args, ","), available)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]registry.Entry, 0, len(dayNumbers))
+	for _, day := range dayNumbers {
+		entry, _ := registry.Lookup(year, day)
+		entries = append(entries, entry)
+	}
+	return entries, nil
 }
@@ -0,0 +1,68 @@
+package puzzle
+
+import (
+	"html"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var (
+	articlePattern   = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	codeBlockPattern = regexp.MustCompile(`(?s)<pre><code>(.*?)</code></pre>`)
+	answerPattern    = regexp.MustCompile(`(?s)<code><em>(.*?)</em></code>|<em><code>(.*?)</code></em>`)
+	tagPattern       = regexp.MustCompile(`<[^>]+>`)
+)
+
+// Example is the example input of one part and the answer the page gives for it
+type Example struct {
+	Part   int
+	Input  string
+	Answer string
+}
+
+// ReadPage reads a puzzle page saved from the site
+func ReadPage(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Examples returns the text of every <pre><code> block on a puzzle page, in page order
+// markup such as <em> is dropped and entities are unescaped, a trailing newline is removed
+func Examples(page string) []string {
+	examples := []string{}
+	for _, match := range codeBlockPattern.FindAllStringSubmatch(page, -1) {
+		examples = append(examples, plainText(match[1]))
+	}
+	return examples
+}
+
+func plainText(fragment string) string {
+	text := html.UnescapeString(tagPattern.ReplaceAllString(fragment, ""))
+	return strings.TrimSuffix(text, "\n")
+}
+
+// Extract finds the example of each part on a puzzle page
+// each part is an <article>, its example is its first <pre><code> block, or the previous
+// part's when it has none, and its answer is the last emphasized code in the article
+// parts without an example or an answer are left out
+func Extract(page string) []Example {
+	examples := []Example{}
+	input := ""
+	for i, article := range articlePattern.FindAllStringSubmatch(page, -1) {
+		if block := codeBlockPattern.FindStringSubmatch(article[1]); block != nil {
+			input = plainText(block[1])
+		}
+		answers := answerPattern.FindAllStringSubmatch(article[1], -1)
+		if input == "" || len(answers) == 0 {
+			continue
+		}
+		last := answers[len(answers)-1]
+		answer := last[1] + last[2]
+		examples = append(examples, Example{Part: i + 1, Input: input, Answer: strings.TrimSpace(plainText(answer))})
+	}
+	return examples
+}
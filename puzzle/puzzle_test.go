@@ -0,0 +1,73 @@
+package puzzle
+
+import (
+	"reflect"
+	"testing"
+)
+
+const samplePage = `<html><body><main>
+<article class="day-desc"><h2>--- Day 1: Secret Entrance ---</h2>
+<p>For example, suppose the attached document contained the following rotations:</p>
+<pre><code>L68
+L30
+R48
+</code></pre>
+<p>Inline <code>R48</code> code is not an example.</p>
+<pre><code>a &lt; b &amp;&amp; <em>c</em>
+</code></pre>
+</article>
+</main></body></html>`
+
+func TestExamples(t *testing.T) {
+	tests := []struct {
+		page     string
+		expected []string
+	}{
+		{samplePage, []string{"L68\nL30\nR48", "a < b && c"}},
+		{"<p>no examples</p>", []string{}},
+	}
+	for _, test := range tests {
+		if result := Examples(test.page); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Examples(%q) = %q, expected %q", test.page, result, test.expected)
+		}
+	}
+}
+
+const sampleSolvedPage = `<main>
+<article class="day-desc"><h2>--- Day 5: Cafeteria ---</h2>
+<pre><code>3-5
+10-14
+
+1
+5
+</code></pre>
+<p>Ingredient ID <code>1</code> is spoiled.</p>
+<p>In this example, <code><em>3</em></code> of the available ingredient IDs are fresh.</p>
+</article>
+<p>Your puzzle answer was <code>744</code>.</p>
+<article class="day-desc"><h2 id="part2">--- Part Two ---</h2>
+<p>So, in this example, the fresh ingredient ID ranges consider a total of <em><code>14</code></em> ingredient IDs to be fresh.</p>
+</article>
+</main>`
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		page     string
+		expected []Example
+	}{
+		{sampleSolvedPage, []Example{
+			{1, "3-5\n10-14\n\n1\n5", "3"},
+			{2, "3-5\n10-14\n\n1\n5", "14"},
+		}},
+		{samplePage, []Example{}},
+		{`<article><pre><code>x</code></pre><p><code><em>1</em></code></p></article><article><pre><code>y</code></pre><p><code><em>2</em></code></p></article>`, []Example{
+			{1, "x", "1"},
+			{2, "y", "2"},
+		}},
+	}
+	for _, test := range tests {
+		if result := Extract(test.page); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Extract(%q) = %q, expected %q", test.page, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"aoc2025/solver"
+)
+
+var (
+	// ErrDuplicate is reported when the same year and day is registered twice
+	ErrDuplicate = errors.New("duplicate registration")
+	// ErrMissing is reported when a day before the last registered day has no registration
+	ErrMissing = errors.New("missing registration")
+)
+
+// Entry describes one registered day
+type Entry struct {
+	Year   int
+	Day    int
+	Title  string
+	Solver solver.Solver
+}
+
+func (e Entry) String() string {
+	return fmt.Sprintf("%d day %d: %s", e.Year, e.Day, e.Title)
+}
+
+type key struct {
+	year int
+	day  int
+}
+
+// Registry keeps track of every registered day
+// the first registration of a day wins, later ones are kept as problems for Check
+type Registry struct {
+	mu         sync.RWMutex
+	entries    map[key]Entry
+	duplicates []Entry
+}
+
+// New makes an empty registry
+func New() *Registry {
+	return &Registry{entries: make(map[key]Entry)}
+}
+
+// Register adds a day to the registry
+func (r *Registry) Register(e Entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k := key{year: e.Year, day: e.Day}
+	if _, exists := r.entries[k]; exists {
+		r.duplicates = append(r.duplicates, e)
+		return
+	}
+	r.entries[k] = e
+}
+
+// RegisterLegacy adds a day written against the old Day contract, running it
+// through solver.FromLegacy
+func (r *Registry) RegisterLegacy(year, day int, title string, legacy solver.Legacy) {
+	r.Register(Entry{Year: year, Day: day, Title: title, Solver: solver.FromLegacy(legacy)})
+}
+
+// All returns every registered day, ordered by year and day
+func (r *Registry) All() []Entry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	entries := make([]Entry, 0, len(r.entries))
+	for _, e := range r.entries {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Year != entries[j].Year {
+			return entries[i].Year < entries[j].Year
+		}
+		return entries[i].Day < entries[j].Day
+	})
+	return entries
+}
+
+// Lookup finds a day in the given year
+func (r *Registry) Lookup(year, day int) (Entry, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.entries[key{year: year, day: day}]
+	return e, ok
+}
+
+// Check reports duplicate registrations, and days missing between day 1
+// and the last registered day of each year
+func (r *Registry) Check() error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	problems := []error{}
+	for _, dup := range r.duplicates {
+		first := r.entries[key{year: dup.Year, day: dup.Day}]
+		problems = append(problems, fmt.Errorf("%w: %d day %d registered as %q and %q", ErrDuplicate, dup.Year, dup.Day, first.Title, dup.Title))
+	}
+
+	lastDay := make(map[int]int)
+	for k := range r.entries {
+		if k.day > lastDay[k.year] {
+			lastDay[k.year] = k.day
+		}
+	}
+	years := make([]int, 0, len(lastDay))
+	for year := range lastDay {
+		years = append(years, year)
+	}
+	sort.Ints(years)
+	for _, year := range years {
+		for day := 1; day < lastDay[year]; day++ {
+			if _, ok := r.entries[key{year: year, day: day}]; !ok {
+				problems = append(problems, fmt.Errorf("%w: %d day %d", ErrMissing, year, day))
+			}
+		}
+	}
+	return errors.Join(problems...)
+}
+
+var defaultRegistry = New()
+
+// Register adds a day to the default registry, day packages call it from init()
+func Register(e Entry) {
+	defaultRegistry.Register(e)
+}
+
+// RegisterLegacy adds a legacy day to the default registry, day packages call it from init()
+func RegisterLegacy(year, day int, title string, legacy solver.Legacy) {
+	defaultRegistry.RegisterLegacy(year, day, title, legacy)
+}
+
+// All returns every day in the default registry, ordered by year and day
+func All() []Entry {
+	return defaultRegistry.All()
+}
+
+// Lookup finds a day in the default registry
+func Lookup(year, day int) (Entry, bool) {
+	return defaultRegistry.Lookup(year, day)
+}
+
+// Check reports problems with the default registry
+func Check() error {
+	return defaultRegistry.Check()
+}
@@ -0,0 +1,109 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"aoc2025/solver"
+)
+
+type fakeSolver struct{}
+
+func (fakeSolver) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	return solver.Int(1), nil
+}
+func (fakeSolver) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	return solver.Int(2), nil
+}
+
+func TestAllIsOrdered(t *testing.T) {
+	r := New()
+	r.Register(Entry{Year: 2025, Day: 3, Title: "c", Solver: fakeSolver{}})
+	r.Register(Entry{Year: 2024, Day: 7, Title: "x", Solver: fakeSolver{}})
+	r.Register(Entry{Year: 2025, Day: 1, Title: "a", Solver: fakeSolver{}})
+	r.Register(Entry{Year: 2025, Day: 2, Title: "b", Solver: fakeSolver{}})
+
+	expected := []struct{ year, day int }{{2024, 7}, {2025, 1}, {2025, 2}, {2025, 3}}
+	entries := r.All()
+	if len(entries) != len(expected) {
+		t.Fatalf("All() = %d entries, expected %d", len(entries), len(expected))
+	}
+	for i, e := range entries {
+		if e.Year != expected[i].year || e.Day != expected[i].day {
+			t.Errorf("All()[%d] = %d day %d, expected %d day %d", i, e.Year, e.Day, expected[i].year, expected[i].day)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	r := New()
+	r.Register(Entry{Year: 2025, Day: 1, Title: "a", Solver: fakeSolver{}})
+	if e, ok := r.Lookup(2025, 1); !ok || e.Title != "a" {
+		t.Errorf("Lookup(2025, 1) = %v, %t, expected a, true", e, ok)
+	}
+	if _, ok := r.Lookup(2025, 2); ok {
+		t.Errorf("Lookup(2025, 2) found an entry, expected none")
+	}
+}
+
+// legacyDay is written against the old Day contract, without part 2
+type legacyDay struct{}
+
+func (legacyDay) Part1(input string) interface{} {
+	return len(input)
+}
+
+func (legacyDay) Part2(input string) (interface{}, bool) {
+	return nil, false
+}
+
+func TestRegisterLegacy(t *testing.T) {
+	r := New()
+	r.RegisterLegacy(2025, 1, "a", legacyDay{})
+	e, ok := r.Lookup(2025, 1)
+	if !ok {
+		t.Fatalf("Lookup(2025, 1) found no entry after RegisterLegacy")
+	}
+	if answer, err := e.Solver.Part1(context.Background(), "abc"); err != nil || !answer.Equal(solver.Int(3)) {
+		t.Errorf("Part1(%q) = %v, %v, expected 3", "abc", answer, err)
+	}
+	if _, err := e.Solver.Part2(context.Background(), "abc"); !errors.Is(err, solver.ErrNotUnlocked) {
+		t.Errorf("Part2() error = %v, expected solver.ErrNotUnlocked", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	t.Run("Clean", func(t *testing.T) {
+		r := New()
+		r.Register(Entry{Year: 2025, Day: 1, Title: "a", Solver: fakeSolver{}})
+		r.Register(Entry{Year: 2025, Day: 2, Title: "b", Solver: fakeSolver{}})
+		if err := r.Check(); err != nil {
+			t.Errorf("Check() unexpected error %v", err)
+		}
+	})
+	t.Run("Duplicate", func(t *testing.T) {
+		r := New()
+		r.Register(Entry{Year: 2025, Day: 1, Title: "a", Solver: fakeSolver{}})
+		r.Register(Entry{Year: 2025, Day: 1, Title: "again", Solver: fakeSolver{}})
+		err := r.Check()
+		if !errors.Is(err, ErrDuplicate) {
+			t.Errorf("Check() = %v, expected %v", err, ErrDuplicate)
+		}
+		if e, _ := r.Lookup(2025, 1); e.Title != "a" {
+			t.Errorf("Lookup(2025, 1) = %q, expected first registration to win", e.Title)
+		}
+	})
+	t.Run("Missing", func(t *testing.T) {
+		r := New()
+		r.Register(Entry{Year: 2025, Day: 1, Title: "a", Solver: fakeSolver{}})
+		r.Register(Entry{Year: 2025, Day: 3, Title: "c", Solver: fakeSolver{}})
+		err := r.Check()
+		if !errors.Is(err, ErrMissing) {
+			t.Errorf("Check() = %v, expected %v", err, ErrMissing)
+		}
+		if errors.Is(err, ErrDuplicate) {
+			t.Errorf("Check() = %v, did not expect %v", err, ErrDuplicate)
+		}
+	})
+}
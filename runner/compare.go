@@ -0,0 +1,120 @@
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+// CompareThreshold is the relative change in median time below which a part counts as unchanged
+const CompareThreshold = 0.05
+
+// Comparison of one part across two exported runs
+type Comparison struct {
+	Day     int
+	Part    int
+	Input   string
+	Old     *Record // nil when the part is only in the new run
+	New     *Record // nil when the part is only in the old run
+	Speedup float64 // old median over new median, 0 when either is missing
+}
+
+// Verdict describes a comparison: faster, slower, same, added, removed or failed
+func (c Comparison) Verdict() string {
+	switch {
+	case c.Old == nil:
+		return "added"
+	case c.New == nil:
+		return "removed"
+	case c.Old.Status != StatusOK || c.New.Status != StatusOK:
+		return "failed"
+	case c.Speedup > 1+CompareThreshold:
+		return "faster"
+	case c.Speedup < 1/(1+CompareThreshold):
+		return "slower"
+	default:
+		return "same"
+	}
+}
+
+// ReadRecords reads records written by WriteJSON
+func ReadRecords(path string) ([]Record, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	records := []Record{}
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return records, nil
+}
+
+type recordKey struct {
+	day   int
+	part  int
+	input string
+}
+
+// Compare matches the records of two runs by day, part and input
+// comparisons follow the order of the new run, with parts missing from it at the end
+func Compare(before, after []Record) []Comparison {
+	oldByKey := map[recordKey]*Record{}
+	for i := range before {
+		r := &before[i]
+		oldByKey[recordKey{r.Day, r.Part, r.Input}] = r
+	}
+	comparisons := []Comparison{}
+	seen := map[recordKey]bool{}
+	for i := range after {
+		r := &after[i]
+		key := recordKey{r.Day, r.Part, r.Input}
+		seen[key] = true
+		c := Comparison{Day: r.Day, Part: r.Part, Input: r.Input, Old: oldByKey[key], New: r}
+		if c.Old != nil && c.Old.MedianNs > 0 && r.MedianNs > 0 {
+			c.Speedup = float64(c.Old.MedianNs) / float64(r.MedianNs)
+		}
+		comparisons = append(comparisons, c)
+	}
+	for i := range before {
+		r := &before[i]
+		if !seen[recordKey{r.Day, r.Part, r.Input}] {
+			comparisons = append(comparisons, Comparison{Day: r.Day, Part: r.Part, Input: r.Input, Old: r})
+		}
+	}
+	return comparisons
+}
+
+func medianOf(r *Record) string {
+	if r == nil {
+		return "-"
+	}
+	if r.Status != StatusOK {
+		return r.Status
+	}
+	return time.Duration(r.MedianNs).String()
+}
+
+func allocsOf(r *Record) string {
+	if r == nil || r.Status != StatusOK {
+		return "-"
+	}
+	return formatBytes(r.AllocBytes)
+}
+
+// WriteComparison writes a table of comparisons with the speedup of each part
+func WriteComparison(w io.Writer, comparisons []Comparison) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Day\tPart\tOld\tNew\tSpeedup\tOld alloc\tNew alloc\tVerdict")
+	for _, c := range comparisons {
+		speedup := "-"
+		if c.Speedup > 0 {
+			speedup = fmt.Sprintf("%.2fx", c.Speedup)
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\n", c.Day, c.Part, medianOf(c.Old), medianOf(c.New), speedup, allocsOf(c.Old), allocsOf(c.New), c.Verdict())
+	}
+	return tw.Flush()
+}
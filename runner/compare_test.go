@@ -0,0 +1,68 @@
+package runner
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	old := []Record{
+		{Day: 1, Part: 1, Status: StatusOK, MedianNs: 1000},
+		{Day: 1, Part: 2, Status: StatusOK, MedianNs: 1000},
+		{Day: 2, Part: 1, Status: StatusOK, MedianNs: 1000},
+		{Day: 3, Part: 1, Status: StatusOK, MedianNs: 1000},
+		{Day: 4, Part: 1, Status: StatusOK, MedianNs: 1000},
+	}
+	after := []Record{
+		{Day: 1, Part: 1, Status: StatusOK, MedianNs: 500},
+		{Day: 1, Part: 2, Status: StatusOK, MedianNs: 2000},
+		{Day: 2, Part: 1, Status: StatusOK, MedianNs: 1020},
+		{Day: 3, Part: 1, Status: StatusTimeout},
+		{Day: 5, Part: 1, Status: StatusOK, MedianNs: 10},
+	}
+	verdicts := []string{}
+	speedups := []float64{}
+	for _, c := range Compare(old, after) {
+		verdicts = append(verdicts, c.Verdict())
+		speedups = append(speedups, c.Speedup)
+	}
+	expected := []string{"faster", "slower", "same", "failed", "added", "removed"}
+	if !reflect.DeepEqual(verdicts, expected) {
+		t.Errorf("Compare() verdicts = %v, expected %v", verdicts, expected)
+	}
+	if speedups[0] != 2 || speedups[1] != 0.5 {
+		t.Errorf("Compare() speedups = %v, expected 2 and 0.5 first", speedups)
+	}
+}
+
+func TestCompareMatchesInputs(t *testing.T) {
+	old := []Record{{Day: 1, Part: 1, Input: "data", Status: StatusOK, MedianNs: 100}}
+	after := []Record{{Day: 1, Part: 1, Input: "stress", Status: StatusOK, MedianNs: 100}}
+	if comparisons := Compare(old, after); len(comparisons) != 2 {
+		t.Errorf("Compare() across inputs = %d comparisons, expected 2", len(comparisons))
+	}
+}
+
+func TestReadRecords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.json")
+	var buf bytes.Buffer
+	records := Records(kSampleResults, []int{1, 2})
+	WriteJSON(&buf, records)
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	result, err := ReadRecords(path)
+	if err != nil || len(result) != len(records) {
+		t.Errorf("ReadRecords() = %d records, %v, expected %d", len(result), err, len(records))
+	}
+
+	buf.Reset()
+	WriteComparison(&buf, Compare(result, result))
+	if !strings.Contains(buf.String(), "1.00x") || !strings.Contains(buf.String(), "same") {
+		t.Errorf("WriteComparison() of a run with itself = %q, expected 1.00x and same", buf.String())
+	}
+}
@@ -0,0 +1,215 @@
+package runner
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"aoc2025/solver"
+)
+
+// Output formats for records, text is the human readable default
+const (
+	FormatText     = "text"
+	FormatJSON     = "json"
+	FormatCSV      = "csv"
+	FormatMarkdown = "markdown"
+)
+
+// Formats lists every supported output format
+var Formats = []string{FormatText, FormatJSON, FormatCSV, FormatMarkdown}
+
+// CheckFormat reports whether format is one of Formats
+func CheckFormat(format string) error {
+	for _, f := range Formats {
+		if f == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown format %q, expected one of %s", format, strings.Join(Formats, ", "))
+}
+
+// Status values used in records
+const (
+	StatusOK          = "ok"
+	StatusNotUnlocked = "not unlocked"
+	StatusError       = "error"
+	StatusTimeout     = "timeout"
+)
+
+// Record is the flat, machine readable form of one part's result
+type Record struct {
+	Day        int           `json:"day"`
+	Part       int           `json:"part"`
+	Title      string        `json:"title"`
+	Input      string        `json:"input,omitempty"`
+	Answer     solver.Answer `json:"answer"` // null when the part has no answer
+	Status     string        `json:"status"`
+	Error      string        `json:"error,omitempty"`
+	Stack      string        `json:"stack,omitempty"` // stack trace when the part panicked
+	Runs       int           `json:"runs"`
+	MinNs      int64         `json:"min_ns"`
+	MedianNs   int64         `json:"median_ns"`
+	P95Ns      int64         `json:"p95_ns"`
+	AllocBytes uint64        `json:"alloc_bytes"`
+	Allocs     uint64        `json:"allocs"`
+}
+
+// Status describes the outcome of a part
+func Status(r Result) string {
+	switch {
+	case errors.Is(r.Err, solver.ErrNotUnlocked):
+		return StatusNotUnlocked
+	case errors.Is(r.Err, context.DeadlineExceeded):
+		return StatusTimeout
+	case r.Err != nil:
+		return StatusError
+	default:
+		return StatusOK
+	}
+}
+
+// Records flattens day results into one record per part
+// a day whose input could not be read gives an error record per part
+func Records(results []DayResult, parts []int) []Record {
+	records := []Record{}
+	for _, r := range results {
+		if r.InputErr != nil {
+			for _, part := range parts {
+				records = append(records, Record{Day: r.Day, Part: part, Title: r.Title, Input: r.InputName, Status: StatusError, Error: r.InputErr.Error()})
+			}
+			continue
+		}
+		for _, part := range r.Parts {
+			record := Record{
+				Day:        r.Day,
+				Part:       part.Part,
+				Title:      r.Title,
+				Input:      r.InputName,
+				Status:     Status(part),
+				Runs:       part.Stats.Runs,
+				MinNs:      part.Stats.Min.Nanoseconds(),
+				MedianNs:   part.Stats.Median.Nanoseconds(),
+				P95Ns:      part.Stats.P95.Nanoseconds(),
+				AllocBytes: part.Stats.AllocBytes,
+				Allocs:     part.Stats.Allocs,
+			}
+			if part.Err != nil {
+				record.Error = part.Err.Error()
+				record.Stack = PanicStack(part.Err)
+			} else {
+				record.Answer = part.Answer
+			}
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// WriteJSON writes the records as an indented JSON array
+func WriteJSON(w io.Writer, records []Record) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(records)
+}
+
+var csvHeader = []string{"day", "part", "title", "input", "answer", "status", "error", "stack", "runs", "min_ns", "median_ns", "p95_ns", "alloc_bytes", "allocs"}
+
+// WriteCSV writes the records as CSV with a header row
+func WriteCSV(w io.Writer, records []Record) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, r := range records {
+		answer := r.Answer.String()
+		row := []string{
+			strconv.Itoa(r.Day),
+			strconv.Itoa(r.Part),
+			r.Title,
+			r.Input,
+			answer,
+			r.Status,
+			r.Error,
+			r.Stack,
+			strconv.Itoa(r.Runs),
+			strconv.FormatInt(r.MinNs, 10),
+			strconv.FormatInt(r.MedianNs, 10),
+			strconv.FormatInt(r.P95Ns, 10),
+			strconv.FormatUint(r.AllocBytes, 10),
+			strconv.FormatUint(r.Allocs, 10),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteMarkdown writes the records as a Markdown table for the README
+// the input column is only added when records come from more than one input
+func WriteMarkdown(w io.Writer, records []Record) error {
+	withInput := false
+	for _, r := range records {
+		if r.Input != "" && r.Input != records[0].Input {
+			withInput = true
+		}
+	}
+	lines := []string{
+		"| Day | Title | Part | Answer | Status | Time | Allocations |",
+		"| --: | ----- | ---: | -----: | ------ | ---: | ----------: |",
+	}
+	if withInput {
+		lines = []string{
+			"| Day | Title | Input | Part | Answer | Status | Time | Allocations |",
+			"| --: | ----- | ----- | ---: | -----: | ------ | ---: | ----------: |",
+		}
+	}
+	for _, r := range records {
+		answer := r.Answer.String()
+		status := r.Status
+		if r.Error != "" && r.Status != StatusNotUnlocked {
+			status += ": " + r.Error
+		}
+		timing, allocations := "", ""
+		if r.Runs > 0 {
+			timing = time.Duration(r.MedianNs).Round(time.Microsecond).String()
+			allocations = fmt.Sprintf("%s / %d", formatBytes(r.AllocBytes), r.Allocs)
+		}
+		title := escapeMarkdown(r.Title)
+		if withInput {
+			title += " | " + escapeMarkdown(r.Input)
+		}
+		lines = append(lines, fmt.Sprintf("| %d | %s | %d | %s | %s | %s | %s |",
+			r.Day, title, r.Part, answer, escapeMarkdown(status), timing, allocations))
+	}
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+// keep table cells on one line and from splitting into extra columns
+func escapeMarkdown(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+// WriteFormat writes the records in a machine readable format
+func WriteFormat(w io.Writer, format string, records []Record) error {
+	switch format {
+	case FormatJSON:
+		return WriteJSON(w, records)
+	case FormatCSV:
+		return WriteCSV(w, records)
+	case FormatMarkdown:
+		return WriteMarkdown(w, records)
+	default:
+		return fmt.Errorf("format %q cannot be written as records", format)
+	}
+}
@@ -0,0 +1,102 @@
+package runner
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"aoc2025/solver"
+)
+
+var kSampleResults = []DayResult{
+	{Day: 1, Title: "Secret Entrance", Parts: []Result{
+		{Day: 1, Part: 1, Answer: solver.Int(3), Duration: time.Millisecond, Stats: Stats{Runs: 1, Min: time.Millisecond, Median: time.Millisecond, P95: time.Millisecond, AllocBytes: 2048, Allocs: 4}},
+		{Day: 1, Part: 2, Err: solver.ErrNotUnlocked, Stats: Stats{Runs: 1}},
+	}},
+	{Day: 2, Title: "Gift | Shop", Parts: []Result{
+		{Day: 2, Part: 1, Err: errors.New("bad range"), Stats: Stats{Runs: 1}},
+	}},
+	{Day: 3, Title: "Lobby", InputErr: errors.New("no input")},
+}
+
+func TestRecords(t *testing.T) {
+	records := Records(kSampleResults, []int{1, 2})
+	expected := []struct {
+		day, part int
+		status    string
+		hasAnswer bool
+	}{
+		{1, 1, StatusOK, true},
+		{1, 2, StatusNotUnlocked, false},
+		{2, 1, StatusError, false},
+		{3, 1, StatusError, false},
+		{3, 2, StatusError, false},
+	}
+	if len(records) != len(expected) {
+		t.Fatalf("Records() = %d records, expected %d", len(records), len(expected))
+	}
+	for i, r := range records {
+		e := expected[i]
+		if r.Day != e.day || r.Part != e.part || r.Status != e.status || r.Answer.IsZero() == e.hasAnswer {
+			t.Errorf("Records()[%d] = %+v, expected day %d part %d status %q", i, r, e.day, e.part, e.status)
+		}
+	}
+}
+
+func TestWriteFormat(t *testing.T) {
+	records := Records(kSampleResults, []int{1, 2})
+
+	t.Run("JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := WriteFormat(&buf, FormatJSON, records); err != nil {
+			t.Fatalf("WriteFormat() unexpected error %v", err)
+		}
+		decoded := []Record{}
+		if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+			t.Fatalf("json.Unmarshal() unexpected error %v", err)
+		}
+		if len(decoded) != len(records) || !decoded[0].Answer.Equal(solver.Int(3)) || decoded[0].MedianNs != int64(time.Millisecond) {
+			t.Errorf("decoded JSON = %+v", decoded)
+		}
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := WriteFormat(&buf, FormatCSV, records); err != nil {
+			t.Fatalf("WriteFormat() unexpected error %v", err)
+		}
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		if len(lines) != len(records)+1 {
+			t.Fatalf("CSV has %d lines, expected %d", len(lines), len(records)+1)
+		}
+		if !strings.HasPrefix(lines[1], "1,1,Secret Entrance,,3,ok,,,1,1000000,1000000,1000000,2048,4") {
+			t.Errorf("CSV row = %q", lines[1])
+		}
+	})
+
+	t.Run("Markdown", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := WriteFormat(&buf, FormatMarkdown, records); err != nil {
+			t.Fatalf("WriteFormat() unexpected error %v", err)
+		}
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		if len(lines) != len(records)+2 {
+			t.Fatalf("Markdown has %d lines, expected %d", len(lines), len(records)+2)
+		}
+		if lines[2] != "| 1 | Secret Entrance | 1 | 3 | ok | 1ms | 2.0 KiB / 4 |" {
+			t.Errorf("Markdown row = %q", lines[2])
+		}
+		if !strings.Contains(lines[4], `Gift \| Shop`) || !strings.Contains(lines[4], "error: bad range") {
+			t.Errorf("Markdown row = %q, expected escaped title and error", lines[4])
+		}
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		if err := CheckFormat("xml"); err == nil {
+			t.Errorf("CheckFormat(xml) expected error, got nil")
+		}
+	})
+}
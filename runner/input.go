@@ -0,0 +1,121 @@
+package runner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"aoc2025/vault"
+)
+
+// Stdin is the input path that reads the puzzle input from standard input
+const Stdin = "-"
+
+// DefaultSet names the inputs kept in dayN/data.txt
+const DefaultSet = "data"
+
+// InputsDir holds named input sets, one directory per set
+const InputsDir = "inputs"
+
+// InputPath is where a day's puzzle input is kept
+func InputPath(day int) string {
+	return fmt.Sprintf("day%d/data.txt", day)
+}
+
+// SetPath is where a day's input is kept in a named input set
+// the default set is dayN/data.txt
+func SetPath(set string, day int) string {
+	if set == "" || set == DefaultSet {
+		return InputPath(day)
+	}
+	return filepath.Join(InputsDir, set, fmt.Sprintf("day%d.txt", day))
+}
+
+// ListSets returns the default set followed by every named set in InputsDir
+func ListSets() ([]string, error) {
+	sets := []string{DefaultSet}
+	entries, err := os.ReadDir(InputsDir)
+	if os.IsNotExist(err) {
+		return sets, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	named := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && entry.Name() != DefaultSet {
+			named = append(named, entry.Name())
+		}
+	}
+	sort.Strings(named)
+	return append(sets, named...), nil
+}
+
+// ReadInput loads a day's puzzle input, from path if given
+// a path of "-" reads standard input, and a missing file is read from its
+// encrypted copy path+".enc" when there is one
+func ReadInput(day int, path string) (string, error) {
+	if path == "" {
+		path = InputPath(day)
+	}
+	var data []byte
+	var err error
+	if path == Stdin {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			data, err = readEncrypted(path, err)
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Opener opens a fresh reader over a day's puzzle input, once for every run
+type Opener func() (io.ReadCloser, error)
+
+// OpenInput is ReadInput for days that stream their input
+// a plain file is opened again for every run, while standard input and
+// encrypted inputs can only be read once and are held in memory
+func OpenInput(day int, path string) (Opener, error) {
+	if path == "" {
+		path = InputPath(day)
+	}
+	if path != Stdin {
+		_, err := os.Stat(path)
+		if err == nil {
+			return func() (io.ReadCloser, error) { return os.Open(path) }, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	input, err := ReadInput(day, path)
+	if err != nil {
+		return nil, err
+	}
+	data := []byte(input)
+	return func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(data)), nil }, nil
+}
+
+// readEncrypted decrypts the encrypted copy of a missing input
+// notExist is returned unchanged when there is no encrypted copy either
+func readEncrypted(path string, notExist error) ([]byte, error) {
+	encrypted := path + vault.Ext
+	if _, err := os.Stat(encrypted); err != nil {
+		return nil, notExist
+	}
+	key, err := vault.LoadKey()
+	if err != nil {
+		return nil, fmt.Errorf("%s is missing and %s needs a key to decrypt: %w", path, encrypted, err)
+	}
+	return vault.ReadFile(key, encrypted)
+}
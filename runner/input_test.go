@@ -0,0 +1,141 @@
+package runner
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"aoc2025/vault"
+)
+
+func TestSetPath(t *testing.T) {
+	tests := []struct {
+		set      string
+		day      int
+		expected string
+	}{
+		{"", 3, "day3/data.txt"},
+		{DefaultSet, 3, "day3/data.txt"},
+		{"example", 10, filepath.Join("inputs", "example", "day10.txt")},
+	}
+	for _, test := range tests {
+		if result := SetPath(test.set, test.day); result != test.expected {
+			t.Errorf("SetPath(%q, %d) = %q, expected %q", test.set, test.day, result, test.expected)
+		}
+	}
+}
+
+func TestListSets(t *testing.T) {
+	t.Chdir(t.TempDir())
+	sets, err := ListSets()
+	if err != nil || !reflect.DeepEqual(sets, []string{DefaultSet}) {
+		t.Errorf("ListSets() without inputs dir = %v, %v, expected only %s", sets, err, DefaultSet)
+	}
+
+	for _, dir := range []string{"stress", "alice", "data"} {
+		os.MkdirAll(filepath.Join(InputsDir, dir), 0o755)
+	}
+	os.WriteFile(filepath.Join(InputsDir, "README"), []byte("not a set"), 0o644)
+	sets, err = ListSets()
+	expected := []string{DefaultSet, "alice", "stress"}
+	if err != nil || !reflect.DeepEqual(sets, expected) {
+		t.Errorf("ListSets() = %v, %v, expected %v", sets, err, expected)
+	}
+}
+
+func TestReadInput(t *testing.T) {
+	t.Chdir(t.TempDir())
+	os.Mkdir("day4", 0o755)
+	os.WriteFile("day4/data.txt", []byte("@@.\n"), 0o644)
+	os.WriteFile("other.txt", []byte("..@\n"), 0o644)
+
+	if input, err := ReadInput(4, ""); err != nil || input != "@@.\n" {
+		t.Errorf("ReadInput(4, \"\") = %q, %v, expected day4/data.txt", input, err)
+	}
+	if input, err := ReadInput(4, "other.txt"); err != nil || input != "..@\n" {
+		t.Errorf("ReadInput(4, other.txt) = %q, %v, expected other.txt", input, err)
+	}
+	if _, err := ReadInput(5, ""); err == nil {
+		t.Errorf("ReadInput(5, \"\") expected error for missing input")
+	}
+
+	stdin := os.Stdin
+	defer func() { os.Stdin = stdin }()
+	r, w, _ := os.Pipe()
+	os.Stdin = r
+	w.WriteString("from stdin")
+	w.Close()
+	if input, err := ReadInput(4, Stdin); err != nil || !strings.HasPrefix(input, "from stdin") {
+		t.Errorf("ReadInput(4, -) = %q, %v, expected stdin", input, err)
+	}
+}
+
+func TestReadEncryptedInput(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv(vault.KeyEnv, "")
+	t.Setenv(vault.KeyFileEnv, "")
+	os.Mkdir("day4", 0o755)
+	key := vault.GenerateKey()
+	vault.WriteFile(key, "day4/data.txt.enc", []byte("@@.\n"))
+
+	if _, err := ReadInput(4, ""); !errors.Is(err, vault.ErrNoKey) {
+		t.Errorf("ReadInput(4, \"\") without a key error = %v, expected ErrNoKey", err)
+	}
+	vault.WriteKeyFile(vault.DefaultKeyFile, key)
+	if input, err := ReadInput(4, ""); err != nil || input != "@@.\n" {
+		t.Errorf("ReadInput(4, \"\") = %q, %v, expected the decrypted input", input, err)
+	}
+	os.WriteFile("day4/data.txt", []byte("plain\n"), 0o644)
+	if input, err := ReadInput(4, ""); err != nil || input != "plain\n" {
+		t.Errorf("ReadInput(4, \"\") = %q, %v, expected the plain input to win", input, err)
+	}
+}
+
+func TestOpenInput(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv(vault.KeyEnv, "")
+	t.Setenv(vault.KeyFileEnv, "")
+	os.Mkdir("day4", 0o755)
+	os.Mkdir("day5", 0o755)
+	os.WriteFile("day4/data.txt", []byte("@@.\n"), 0o644)
+	key := vault.GenerateKey()
+	vault.WriteKeyFile(vault.DefaultKeyFile, key)
+	vault.WriteFile(key, "day5/data.txt.enc", []byte("3-5\n"))
+
+	read := func(open Opener) string {
+		r, err := open()
+		if err != nil {
+			t.Fatalf("Opener() error = %v", err)
+		}
+		defer r.Close()
+		data, _ := io.ReadAll(r)
+		return string(data)
+	}
+	tests := []struct {
+		day      int
+		expected string
+	}{
+		{4, "@@.\n"},
+		{5, "3-5\n"},
+	}
+	for _, test := range tests {
+		open, err := OpenInput(test.day, "")
+		if err != nil {
+			t.Fatalf("OpenInput(%d, \"\") error = %v", test.day, err)
+		}
+		// every run opens the input again from the start
+		for run := 0; run < 2; run++ {
+			if result := read(open); result != test.expected {
+				t.Errorf("OpenInput(%d, \"\") run %d read %q, expected %q", test.day, run, result, test.expected)
+			}
+		}
+	}
+	if _, err := OpenInput(6, ""); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("OpenInput(6, \"\") error = %v, expected a missing file", err)
+	}
+}
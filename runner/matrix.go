@@ -0,0 +1,55 @@
+package runner
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// WriteMatrix writes a table with a row per day and part, and a column
+// per input set, so the answers for every input can be compared side by side
+func WriteMatrix(w io.Writer, results []DayResult, sets []string, parts []int) error {
+	type row struct {
+		day, part int
+	}
+	rows := []row{}
+	seen := make(map[row]bool)
+	cells := make(map[row]map[string]string)
+	titles := make(map[int]string)
+	add := func(r row, set, value string) {
+		if !seen[r] {
+			seen[r] = true
+			rows = append(rows, r)
+			cells[r] = make(map[string]string)
+		}
+		cells[r][set] = value
+	}
+
+	for _, result := range results {
+		titles[result.Day] = result.Title
+		if result.InputErr != nil {
+			for _, part := range parts {
+				add(row{day: result.Day, part: part}, result.InputName, "no input")
+			}
+			continue
+		}
+		for _, part := range result.Parts {
+			add(row{day: result.Day, part: part.Part}, result.InputName, PartSummary(part))
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Day\tTitle\tPart\t%s\n", strings.Join(sets, "\t"))
+	for _, r := range rows {
+		values := make([]string, len(sets))
+		for i, set := range sets {
+			values[i] = cells[r][set]
+			if values[i] == "" {
+				values[i] = "-"
+			}
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%d\t%s\n", r.day, titles[r.day], r.part, strings.Join(values, "\t"))
+	}
+	return tw.Flush()
+}
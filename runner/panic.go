@@ -0,0 +1,47 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+
+	"aoc2025/solver"
+)
+
+// PanicError is the failure recorded when a part panics
+type PanicError struct {
+	Value interface{}
+	Stack string
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap exposes the panic value when it was itself an error
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// protect calls f, turning a panic into a PanicError so one broken part
+// does not stop the remaining days
+// panics in goroutines started by f cannot be caught here
+func protect(f func() (solver.Answer, error)) (answer solver.Answer, err error) {
+	defer func() {
+		if value := recover(); value != nil {
+			answer = solver.Answer{}
+			err = &PanicError{Value: value, Stack: string(debug.Stack())}
+		}
+	}()
+	return f()
+}
+
+// PanicStack returns the stack trace if err came from a panic
+func PanicStack(err error) string {
+	var panicErr *PanicError
+	if errors.As(err, &panicErr) {
+		return panicErr.Stack
+	}
+	return ""
+}
@@ -0,0 +1,56 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"aoc2025/solver"
+)
+
+type panickySolver struct{}
+
+func (panickySolver) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	panic("bad input")
+}
+
+func (panickySolver) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	var values []int
+	return solver.Int(values[3]), nil
+}
+
+func TestRunPartRecoversPanic(t *testing.T) {
+	result := RunPart(context.Background(), panickySolver{}, 6, 1, "", Options{})
+	var panicErr *PanicError
+	if !errors.As(result.Err, &panicErr) {
+		t.Fatalf("RunPart() error = %v, expected a PanicError", result.Err)
+	}
+	if panicErr.Value != "bad input" {
+		t.Errorf("PanicError.Value = %v, expected bad input", panicErr.Value)
+	}
+	if !strings.Contains(PanicStack(result.Err), "panickySolver") {
+		t.Errorf("PanicStack() = %q, expected the panicking frame", PanicStack(result.Err))
+	}
+}
+
+func TestRunAllContinuesAfterPanic(t *testing.T) {
+	jobs := []Job{
+		{Day: 1, Solver: panickySolver{}},
+		{Day: 2, Solver: slowSolver{day: 2}},
+	}
+	results := RunAll(context.Background(), jobs, Options{}, nil)
+	for _, part := range results[0].Parts {
+		if PanicStack(part.Err) == "" {
+			t.Errorf("day 1 part %d error = %v, expected a panic with stack", part.Part, part.Err)
+		}
+	}
+	if results[1].Parts[0].Err != nil || !results[1].Parts[0].Answer.Equal(solver.Int(2)) {
+		t.Errorf("day 2 part 1 = %v, %v, expected 2", results[1].Parts[0].Answer, results[1].Parts[0].Err)
+	}
+
+	records := Records(results, []int{1, 2})
+	if records[0].Status != StatusError || records[0].Stack == "" || !strings.Contains(records[0].Error, "bad input") {
+		t.Errorf("Records()[0] = %+v, expected an error record with the panic and stack", records[0])
+	}
+}
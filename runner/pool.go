@@ -0,0 +1,109 @@
+package runner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"aoc2025/solver"
+)
+
+// Job is one day to run, with its input already loaded
+// or, for days that implement solver.Streamer, ready to be opened
+type Job struct {
+	Day       int
+	Title     string
+	Solver    solver.Solver
+	InputName string // input set or path the input came from, for reports
+	Input     string
+	Open      Opener // streams the input instead of Input when the solver is a Streamer
+	InputErr  error  // set when the input could not be loaded, the day is not run
+}
+
+// DayResult collects the results of every part of a job
+type DayResult struct {
+	Day       int
+	Title     string
+	InputName string
+	InputErr  error
+	Parts     []Result
+}
+
+// Duration is the total time spent in all parts of the day
+func (r DayResult) Duration() time.Duration {
+	total := time.Duration(0)
+	for _, part := range r.Parts {
+		total += part.Duration
+	}
+	return total
+}
+
+// Options control how RunAll runs the jobs
+type Options struct {
+	Parts    []int         // parts to run, both when empty
+	Parallel int           // number of days run at the same time
+	Repeat   int           // number of runs per part, for timing
+	Timeout  time.Duration // time limit for each run of a part, none when zero
+	Profile  Profile       // profiles to write for each part
+}
+
+// RunAll runs the selected parts of every job on a pool of parallel workers
+// results are returned, and handed to onDone, in the order of jobs no matter
+// which worker finishes first
+func RunAll(ctx context.Context, jobs []Job, opts Options, onDone func(DayResult)) []DayResult {
+	parallel := max(opts.Parallel, 1)
+	if len(opts.Parts) == 0 {
+		opts.Parts = []int{1, 2}
+	}
+	results := make([]DayResult, len(jobs))
+	done := make([]chan struct{}, len(jobs))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	work := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < parallel; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				results[i] = runJob(ctx, jobs[i], opts)
+				close(done[i])
+			}
+		}()
+	}
+	go func() {
+		for i := range jobs {
+			work <- i
+		}
+		close(work)
+	}()
+
+	// report in job order as soon as each job and all jobs before it are done
+	for i := range jobs {
+		<-done[i]
+		if onDone != nil {
+			onDone(results[i])
+		}
+	}
+	wg.Wait()
+	return results
+}
+
+func runJob(ctx context.Context, job Job, opts Options) DayResult {
+	result := DayResult{Day: job.Day, Title: job.Title, InputName: job.InputName, InputErr: job.InputErr}
+	opts.Profile.Input = job.InputName
+	if job.InputErr != nil {
+		return result
+	}
+	streamer, streaming := job.Solver.(solver.Streamer)
+	for _, part := range opts.Parts {
+		if streaming && job.Open != nil {
+			result.Parts = append(result.Parts, RunPartStream(ctx, streamer, job.Day, part, job.Open, opts))
+			continue
+		}
+		result.Parts = append(result.Parts, RunPart(ctx, job.Solver, job.Day, part, job.Input, opts))
+	}
+	return result
+}
@@ -0,0 +1,53 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"aoc2025/solver"
+)
+
+// slowSolver answers with its day number, the lowest days taking the longest
+type slowSolver struct {
+	day int
+}
+
+func (s slowSolver) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	time.Sleep(time.Duration(10-s.day) * time.Millisecond)
+	return solver.Int(s.day), nil
+}
+
+func (s slowSolver) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	return solver.Int(s.day * 10), nil
+}
+
+func TestRunAllKeepsOrder(t *testing.T) {
+	jobs := []Job{}
+	for day := 1; day <= 8; day++ {
+		jobs = append(jobs, Job{Day: day, Solver: slowSolver{day: day}})
+	}
+	jobs = append(jobs, Job{Day: 9, InputErr: errors.New("no input")})
+
+	for _, parallel := range []int{1, 4} {
+		reported := []int{}
+		results := RunAll(context.Background(), jobs, Options{Parts: []int{1, 2}, Parallel: parallel}, func(r DayResult) {
+			reported = append(reported, r.Day)
+		})
+		for i, r := range results {
+			if r.Day != jobs[i].Day || reported[i] != jobs[i].Day {
+				t.Errorf("parallel %d: result %d is day %d, reported day %d, expected day %d", parallel, i, r.Day, reported[i], jobs[i].Day)
+			}
+			if r.InputErr != nil {
+				if len(r.Parts) != 0 {
+					t.Errorf("parallel %d: day %d ran %d parts without input", parallel, r.Day, len(r.Parts))
+				}
+				continue
+			}
+			if len(r.Parts) != 2 || !r.Parts[0].Answer.Equal(solver.Int(r.Day)) || !r.Parts[1].Answer.Equal(solver.Int(r.Day*10)) {
+				t.Errorf("parallel %d: day %d parts = %+v", parallel, r.Day, r.Parts)
+			}
+		}
+	}
+}
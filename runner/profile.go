@@ -0,0 +1,119 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"strings"
+)
+
+// Profile names the directories profiles are written to, one file per day, part
+// and input set
+// CPU profiles and traces cover the process, so profiled days must run one at a time
+// a part that times out and does not notice the cancellation keeps running in the
+// background, so it shows up in the profiles of the parts after it
+type Profile struct {
+	CPUDir   string // dayN-partP.cpu.pprof
+	MemDir   string // dayN-partP.mem.pprof, the heap after the part
+	TraceDir string // dayN-partP.trace, for go tool trace
+	Input    string // input set the part runs on, set by RunAll for every job
+}
+
+// Enabled reports whether any profile is asked for
+func (p Profile) Enabled() bool {
+	return p.CPUDir != "" || p.MemDir != "" || p.TraceDir != ""
+}
+
+// ProfilePath is the file a profile of kind, cpu.pprof, mem.pprof or trace, is written to
+// an input other than the default set is added to the name, dayN-partP-INPUT.kind,
+// so the sets of a matrix run do not overwrite each other
+func ProfilePath(dir string, day, part int, input, kind string) string {
+	name := fmt.Sprintf("day%d-part%d", day, part)
+	if input != "" && input != DefaultSet {
+		name += "-" + fileSafe(input)
+	}
+	return filepath.Join(dir, name+"."+kind)
+}
+
+// fileSafe replaces the characters of name that do not belong in a file name,
+// such as the separators of an input path
+func fileSafe(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+func (p Profile) create(dir string, day, part int, kind string) (*os.File, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return os.Create(ProfilePath(dir, day, part, p.Input, kind))
+}
+
+// start begins the CPU profile and trace of a part
+// the returned stop ends them and writes the heap profile
+func (p Profile) start(day, part int) (stop func() error, err error) {
+	var cpuFile, traceFile *os.File
+	stop = func() error {
+		errs := []error{}
+		if cpuFile != nil {
+			pprof.StopCPUProfile()
+			errs = append(errs, cpuFile.Close())
+		}
+		if traceFile != nil {
+			trace.Stop()
+			errs = append(errs, traceFile.Close())
+		}
+		if p.MemDir != "" {
+			errs = append(errs, p.writeHeapProfile(day, part))
+		}
+		return errors.Join(errs...)
+	}
+
+	if p.CPUDir != "" {
+		f, err := p.create(p.CPUDir, day, part, "cpu.pprof")
+		if err != nil {
+			return nil, errors.Join(err, stop())
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, errors.Join(err, stop())
+		}
+		cpuFile = f
+	}
+	if p.TraceDir != "" {
+		f, err := p.create(p.TraceDir, day, part, "trace")
+		if err != nil {
+			return nil, errors.Join(err, stop())
+		}
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			return nil, errors.Join(err, stop())
+		}
+		traceFile = f
+	}
+	return stop, nil
+}
+
+func (p Profile) writeHeapProfile(day, part int) error {
+	f, err := p.create(p.MemDir, day, part, "mem.pprof")
+	if err != nil {
+		return err
+	}
+	// a collection first so the profile shows up to date live heap figures
+	runtime.GC()
+	if err := pprof.WriteHeapProfile(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
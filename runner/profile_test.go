@@ -0,0 +1,47 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunPartProfile(t *testing.T) {
+	dir := t.TempDir()
+	profile := Profile{
+		CPUDir:   filepath.Join(dir, "cpu"),
+		MemDir:   filepath.Join(dir, "mem"),
+		TraceDir: filepath.Join(dir, "trace"),
+	}
+	result := RunPart(context.Background(), allocSolver{}, 7, 2, "", Options{Repeat: 2, Profile: profile})
+	if result.Err != nil {
+		t.Fatalf("RunPart() with profiles unexpected error %v", result.Err)
+	}
+	for _, path := range []string{
+		filepath.Join(dir, "cpu", "day7-part2.cpu.pprof"),
+		filepath.Join(dir, "mem", "day7-part2.mem.pprof"),
+		filepath.Join(dir, "trace", "day7-part2.trace"),
+	} {
+		if info, err := os.Stat(path); err != nil || info.Size() == 0 {
+			t.Errorf("RunPart() with profiles did not write %s: %v", path, err)
+		}
+	}
+}
+
+func TestProfilePath(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", filepath.Join("p", "day3-part1.cpu.pprof")},
+		{DefaultSet, filepath.Join("p", "day3-part1.cpu.pprof")},
+		{"friend", filepath.Join("p", "day3-part1-friend.cpu.pprof")},
+		{"tmp/day3.txt", filepath.Join("p", "day3-part1-tmp_day3_txt.cpu.pprof")},
+	}
+	for _, test := range tests {
+		if result := ProfilePath("p", 3, 1, test.input, "cpu.pprof"); result != test.expected {
+			t.Errorf("ProfilePath(p, 3, 1, %q, cpu.pprof) = %q, expected %q", test.input, result, test.expected)
+		}
+	}
+}
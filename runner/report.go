@@ -0,0 +1,36 @@
+package runner
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// PartSummary is a short description of a part's outcome for tables
+func PartSummary(r Result) string {
+	if status := Status(r); status != StatusOK {
+		return status
+	}
+	return r.Answer.String()
+}
+
+// WriteSummary writes a table with one row per day and its answers
+func WriteSummary(w io.Writer, results []DayResult) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Day\tTitle\tPart 1\tPart 2\tTime")
+	total := time.Duration(0)
+	for _, r := range results {
+		answers := map[int]string{1: "-", 2: "-"}
+		if r.InputErr != nil {
+			answers[1], answers[2] = "no input", "no input"
+		}
+		for _, part := range r.Parts {
+			answers[part.Part] = PartSummary(part)
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%v\n", r.Day, r.Title, answers[1], answers[2], r.Duration().Round(time.Microsecond))
+		total += r.Duration()
+	}
+	fmt.Fprintf(tw, "\t\t\tTotal\t%v\n", total.Round(time.Microsecond))
+	return tw.Flush()
+}
@@ -0,0 +1,155 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	"aoc2025/solver"
+)
+
+// Result is the outcome of running one part of a day
+type Result struct {
+	Day      int
+	Part     int
+	Answer   solver.Answer
+	Err      error
+	Duration time.Duration // median wall time over all runs
+	Stats    Stats
+}
+
+// RunPart runs a single part of a solver opts.Repeat times and measures it
+// the answer comes from the first run, and it stops at the first error
+// each run is cancelled after opts.Timeout, if set, and all runs together are
+// profiled as opts.Profile asks
+func RunPart(ctx context.Context, s solver.Solver, day, part int, input string, opts Options) Result {
+	return runPart(ctx, day, part, opts, func(ctx context.Context) (solver.Answer, error) {
+		return solvePart(ctx, s, part, input)
+	})
+}
+
+// RunPartStream is RunPart for a day that streams its input
+// open is called for every run, and the time to read the input counts
+func RunPartStream(ctx context.Context, s solver.Streamer, day, part int, open Opener, opts Options) Result {
+	return runPart(ctx, day, part, opts, func(ctx context.Context) (solver.Answer, error) {
+		return streamPart(ctx, s, part, open)
+	})
+}
+
+func runPart(ctx context.Context, day, part int, opts Options, solve func(ctx context.Context) (solver.Answer, error)) (result Result) {
+	repeat := max(opts.Repeat, 1)
+	result = Result{Day: day, Part: part}
+	if opts.Profile.Enabled() {
+		stop, err := opts.Profile.start(day, part)
+		if err != nil {
+			result.Err = fmt.Errorf("starting profile: %w", err)
+			return result
+		}
+		defer func() {
+			if err := stop(); err != nil && result.Err == nil {
+				result.Err = fmt.Errorf("writing profile: %w", err)
+			}
+		}()
+	}
+	durations := make([]time.Duration, 0, repeat)
+	var allocBytes, allocs uint64
+	for i := 0; i < repeat; i++ {
+		answer, sample, err := measure(func() (solver.Answer, error) {
+			return runWithTimeout(ctx, opts.Timeout, solve)
+		})
+		if i == 0 {
+			result.Answer = answer
+		}
+		durations = append(durations, sample.duration)
+		allocBytes += sample.allocBytes
+		allocs += sample.allocs
+		if err != nil {
+			result.Err = err
+			break
+		}
+	}
+	result.Stats = summarize(durations, allocBytes, allocs)
+	result.Duration = result.Stats.Median
+	return result
+}
+
+// runWithTimeout runs f with a context that is cancelled after timeout
+// solvers are expected to notice the cancellation and return, but if one
+// does not, the runner stops waiting for it and reports the timeout anyway
+func runWithTimeout(ctx context.Context, timeout time.Duration, f func(ctx context.Context) (solver.Answer, error)) (solver.Answer, error) {
+	if timeout <= 0 {
+		return protect(func() (solver.Answer, error) { return f(ctx) })
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type outcome struct {
+		answer solver.Answer
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		answer, err := protect(func() (solver.Answer, error) { return f(ctx) })
+		done <- outcome{answer: answer, err: err}
+	}()
+	select {
+	case o := <-done:
+		if o.err != nil && ctx.Err() != nil {
+			return solver.Answer{}, ctx.Err()
+		}
+		return o.answer, o.err
+	case <-ctx.Done():
+		return solver.Answer{}, ctx.Err()
+	}
+}
+
+func solvePart(ctx context.Context, s solver.Solver, part int, input string) (solver.Answer, error) {
+	switch part {
+	case 1:
+		return s.Part1(ctx, input)
+	case 2:
+		return s.Part2(ctx, input)
+	default:
+		return solver.Answer{}, fmt.Errorf("invalid part %d", part)
+	}
+}
+
+func streamPart(ctx context.Context, s solver.Streamer, part int, open Opener) (solver.Answer, error) {
+	r, err := open()
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	defer r.Close()
+	switch part {
+	case 1:
+		return s.Part1Reader(ctx, r)
+	case 2:
+		return s.Part2Reader(ctx, r)
+	default:
+		return solver.Answer{}, fmt.Errorf("invalid part %d", part)
+	}
+}
+
+type sample struct {
+	duration   time.Duration
+	allocBytes uint64
+	allocs     uint64
+}
+
+// measure times f and counts the heap allocations made while it runs
+// the counters are process wide, so other goroutines allocating at the
+// same time are counted too
+func measure(f func() (solver.Answer, error)) (solver.Answer, sample, error) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	answer, err := f()
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+	return answer, sample{
+		duration:   elapsed,
+		allocBytes: after.TotalAlloc - before.TotalAlloc,
+		allocs:     after.Mallocs - before.Mallocs,
+	}, err
+}
@@ -0,0 +1,118 @@
+package runner
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseDays turns a day selection into a sorted list of unique day numbers
+// a selection is a comma separated list of days and ranges, "1,4,9" or "3-7"
+// an empty selection or "all" selects every day in available
+func ParseDays(spec string, available []int) ([]int, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" || spec == "all" {
+		days := make([]int, len(available))
+		copy(days, available)
+		sort.Ints(days)
+		return days, nil
+	}
+
+	known := make(map[int]bool, len(available))
+	first, last := 0, -1
+	for i, day := range available {
+		known[day] = true
+		if i == 0 || day < first {
+			first = day
+		}
+		if i == 0 || day > last {
+			last = day
+		}
+	}
+
+	seen := make(map[int]bool)
+	days := []int{}
+	outside := []string{}
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		start, end, err := parseRange(item)
+		if err != nil {
+			return nil, err
+		}
+		// only days between the first and last available are walked, so a
+		// huge range costs no more than a small one
+		if start < first {
+			outside = append(outside, formatDays(start, min(end, first-1)))
+		}
+		if end > last {
+			outside = append(outside, formatDays(max(start, last+1), end))
+		}
+		for day := max(start, first); day <= min(end, last); day++ {
+			if !seen[day] {
+				seen[day] = true
+				days = append(days, day)
+			}
+		}
+	}
+	sort.Ints(days)
+
+	unknown := outside
+	for _, day := range days {
+		if !known[day] {
+			unknown = append(unknown, strconv.Itoa(day))
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("day %s not implemented", strings.Join(unknown, ", "))
+	}
+	return days, nil
+}
+
+// ParseParts turns "1", "2" or "1,2" into a sorted list of parts
+// an empty selection selects both parts
+func ParseParts(spec string) ([]int, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" || spec == "all" {
+		return []int{1, 2}, nil
+	}
+	seen := make(map[int]bool)
+	parts := []int{}
+	for _, item := range strings.Split(spec, ",") {
+		part, err := strconv.Atoi(strings.TrimSpace(item))
+		if err != nil || part < 1 || part > 2 {
+			return nil, fmt.Errorf("invalid part %q, expected 1 or 2", item)
+		}
+		if !seen[part] {
+			seen[part] = true
+			parts = append(parts, part)
+		}
+	}
+	sort.Ints(parts)
+	return parts, nil
+}
+
+// parse "N" or "A-B" into an inclusive range of days
+func parseRange(item string) (int, int, error) {
+	startStr, endStr, isRange := strings.Cut(item, "-")
+	start, err := strconv.Atoi(strings.TrimSpace(startStr))
+	if err != nil || start < 1 {
+		return 0, 0, fmt.Errorf("invalid day %q", item)
+	}
+	if !isRange {
+		return start, start, nil
+	}
+	end, err := strconv.Atoi(strings.TrimSpace(endStr))
+	if err != nil || end < start {
+		return 0, 0, fmt.Errorf("invalid day range %q", item)
+	}
+	return start, end, nil
+}
+
+// formatDays writes the days from start to end as a single day or a range
+func formatDays(start, end int) string {
+	if start == end {
+		return strconv.Itoa(start)
+	}
+	return fmt.Sprintf("%d-%d", start, end)
+}
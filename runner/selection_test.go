@@ -0,0 +1,77 @@
+package runner
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var kAvailableDays = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+func TestParseDays(t *testing.T) {
+	tests := []struct {
+		spec     string
+		expected []int
+	}{
+		{"", kAvailableDays},
+		{"all", kAvailableDays},
+		{"5", []int{5}},
+		{"3-7", []int{3, 4, 5, 6, 7}},
+		{"1,4,9", []int{1, 4, 9}},
+		{"9,1,4", []int{1, 4, 9}},
+		{"1-3,2,10", []int{1, 2, 3, 10}},
+		{" 2 , 4-5 ", []int{2, 4, 5}},
+	}
+	for _, test := range tests {
+		result, err := ParseDays(test.spec, kAvailableDays)
+		if err != nil {
+			t.Errorf("ParseDays(%q) unexpected error %v", test.spec, err)
+			continue
+		}
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("ParseDays(%q) = %v, expected %v", test.spec, result, test.expected)
+		}
+	}
+}
+
+func TestParseDaysInvalid(t *testing.T) {
+	for _, spec := range []string{"x", "0", "7-3", "1-", "-2", "1,,2", "11", "9-12"} {
+		if result, err := ParseDays(spec, kAvailableDays); err == nil {
+			t.Errorf("ParseDays(%q) = %v, expected error", spec, result)
+		}
+	}
+
+	// a huge range is rejected without walking every day in it
+	start := time.Now()
+	_, err := ParseDays("1-1000000000", kAvailableDays)
+	if err == nil || err.Error() != "day 11-1000000000 not implemented" {
+		t.Errorf("ParseDays(1-1000000000) error = %v, expected day 11-1000000000 not implemented", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("ParseDays(1-1000000000) took %v", elapsed)
+	}
+}
+
+func TestParseParts(t *testing.T) {
+	tests := []struct {
+		spec     string
+		expected []int
+		wantErr  bool
+	}{
+		{"", []int{1, 2}, false},
+		{"1", []int{1}, false},
+		{"2,1", []int{1, 2}, false},
+		{"3", nil, true},
+		{"a", nil, true},
+	}
+	for _, test := range tests {
+		result, err := ParseParts(test.spec)
+		if (err != nil) != test.wantErr {
+			t.Errorf("ParseParts(%q) error = %v, wantErr %t", test.spec, err, test.wantErr)
+			continue
+		}
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("ParseParts(%q) = %v, expected %v", test.spec, result, test.expected)
+		}
+	}
+}
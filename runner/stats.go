@@ -0,0 +1,68 @@
+package runner
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Stats describes the cost of a part over one or more runs
+type Stats struct {
+	Runs       int
+	Min        time.Duration
+	Median     time.Duration
+	P95        time.Duration
+	AllocBytes uint64 // bytes allocated per run
+	Allocs     uint64 // heap allocations per run
+}
+
+func (s Stats) String() string {
+	if s.Runs <= 1 {
+		return fmt.Sprintf("%v, %s in %d allocs", s.Median.Round(time.Microsecond), formatBytes(s.AllocBytes), s.Allocs)
+	}
+	return fmt.Sprintf("min %v, median %v, p95 %v over %d runs, %s in %d allocs per run",
+		s.Min.Round(time.Microsecond), s.Median.Round(time.Microsecond), s.P95.Round(time.Microsecond),
+		s.Runs, formatBytes(s.AllocBytes), s.Allocs)
+}
+
+// summarize the wall times of all runs and average the allocations per run
+func summarize(durations []time.Duration, allocBytes, allocs uint64) Stats {
+	if len(durations) == 0 {
+		return Stats{}
+	}
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	runs := uint64(len(sorted))
+	return Stats{
+		Runs:       len(sorted),
+		Min:        sorted[0],
+		Median:     percentile(sorted, 50),
+		P95:        percentile(sorted, 95),
+		AllocBytes: allocBytes / runs,
+		Allocs:     allocs / runs,
+	}
+}
+
+// percentile of sorted durations using the nearest rank method
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100 // ceil(p/100 * n)
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
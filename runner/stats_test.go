@@ -0,0 +1,64 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"aoc2025/solver"
+)
+
+func TestSummarize(t *testing.T) {
+	durations := []time.Duration{}
+	for i := 20; i >= 1; i-- {
+		durations = append(durations, time.Duration(i)*time.Millisecond)
+	}
+	stats := summarize(durations, 2000, 40)
+	expected := Stats{
+		Runs:       20,
+		Min:        1 * time.Millisecond,
+		Median:     10 * time.Millisecond,
+		P95:        19 * time.Millisecond,
+		AllocBytes: 100,
+		Allocs:     2,
+	}
+	if stats != expected {
+		t.Errorf("summarize() = %+v, expected %+v", stats, expected)
+	}
+}
+
+func TestSummarizeSingleRun(t *testing.T) {
+	stats := summarize([]time.Duration{5 * time.Millisecond}, 10, 1)
+	if stats.Min != stats.Median || stats.Median != stats.P95 || stats.Runs != 1 {
+		t.Errorf("summarize() = %+v, expected min, median and p95 to be the only run", stats)
+	}
+}
+
+type allocSolver struct{}
+
+var sink []byte
+
+func (allocSolver) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	sink = make([]byte, 1<<16)
+	return solver.Int(len(sink)), nil
+}
+
+func (allocSolver) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	return solver.Int(0), nil
+}
+
+func TestRunPartRepeated(t *testing.T) {
+	result := RunPart(context.Background(), allocSolver{}, 1, 1, "", Options{Repeat: 5})
+	if result.Err != nil || !result.Answer.Equal(solver.Int(1<<16)) {
+		t.Fatalf("RunPart() = %v, %v, expected %d", result.Answer, result.Err, 1<<16)
+	}
+	if result.Stats.Runs != 5 {
+		t.Errorf("Stats.Runs = %d, expected 5", result.Stats.Runs)
+	}
+	if result.Stats.AllocBytes < 1<<16 {
+		t.Errorf("Stats.AllocBytes = %d, expected at least %d", result.Stats.AllocBytes, 1<<16)
+	}
+	if result.Stats.Min > result.Stats.Median || result.Stats.Median > result.Stats.P95 {
+		t.Errorf("Stats = %+v, expected min <= median <= p95", result.Stats)
+	}
+}
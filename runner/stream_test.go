@@ -0,0 +1,68 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"aoc2025/solver"
+)
+
+// lineCounter counts input lines, part 1 as a Solver and part 2 only as a Streamer
+// so the tests can tell which path the runner took
+type lineCounter struct{}
+
+func (lineCounter) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	return solver.Int(strings.Count(input, "\n")), nil
+}
+
+func (lineCounter) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	return solver.Answer{}, errors.New("expected the streaming part")
+}
+
+func (lineCounter) Part1Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	return solver.Answer{}, errors.New("expected the string part")
+}
+
+func (lineCounter) Part2Reader(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	data, err := io.ReadAll(r)
+	return solver.Int(strings.Count(string(data), "\n")), err
+}
+
+func TestRunPartStream(t *testing.T) {
+	opens := 0
+	open := func() (io.ReadCloser, error) {
+		opens++
+		return io.NopCloser(strings.NewReader("a\nb\nc\n")), nil
+	}
+	result := RunPartStream(context.Background(), lineCounter{}, 1, 2, open, Options{Repeat: 3})
+	if result.Err != nil || !result.Answer.Equal(solver.Int(3)) {
+		t.Errorf("RunPartStream() = %v, %v, expected 3", result.Answer, result.Err)
+	}
+	if opens != 3 {
+		t.Errorf("RunPartStream() opened the input %d times, expected 3", opens)
+	}
+
+	failing := func() (io.ReadCloser, error) { return nil, io.ErrUnexpectedEOF }
+	if result := RunPartStream(context.Background(), lineCounter{}, 1, 2, failing, Options{}); !errors.Is(result.Err, io.ErrUnexpectedEOF) {
+		t.Errorf("RunPartStream() error = %v, expected the open error", result.Err)
+	}
+}
+
+func TestRunAllPrefersStreamer(t *testing.T) {
+	open := func() (io.ReadCloser, error) { return io.NopCloser(strings.NewReader("a\nb\n")), nil }
+	jobs := []Job{
+		{Day: 1, Solver: lineCounter{}, Open: open},
+		{Day: 2, Solver: lineCounter{}, Input: "a\n"},
+	}
+	results := RunAll(context.Background(), jobs, Options{Parts: []int{2}}, nil)
+	if part := results[0].Parts[0]; part.Err != nil || !part.Answer.Equal(solver.Int(2)) {
+		t.Errorf("streamed day part 2 = %v, %v, expected 2", part.Answer, part.Err)
+	}
+	// without an opener the runner falls back to the string input
+	if part := results[1].Parts[0]; part.Err == nil {
+		t.Errorf("day without an opener part 2 = %v, expected the Solver error", part.Answer)
+	}
+}
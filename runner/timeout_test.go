@@ -0,0 +1,68 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"aoc2025/solver"
+)
+
+// cooperativeSolver runs until its context is cancelled
+type cooperativeSolver struct {
+	stopped chan struct{}
+}
+
+func (s cooperativeSolver) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	<-ctx.Done()
+	close(s.stopped)
+	return solver.Answer{}, ctx.Err()
+}
+
+func (s cooperativeSolver) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	return solver.Int(2), nil
+}
+
+// stubbornSolver ignores its context
+type stubbornSolver struct{}
+
+func (stubbornSolver) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	time.Sleep(time.Second)
+	return solver.Int(1), nil
+}
+
+func (stubbornSolver) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	return solver.Int(2), nil
+}
+
+func TestRunPartTimeout(t *testing.T) {
+	t.Run("Cooperative", func(t *testing.T) {
+		s := cooperativeSolver{stopped: make(chan struct{})}
+		result := RunPart(context.Background(), s, 1, 1, "", Options{Timeout: 10 * time.Millisecond})
+		if !errors.Is(result.Err, context.DeadlineExceeded) || Status(result) != StatusTimeout {
+			t.Errorf("RunPart() error = %v, status %s, expected timeout", result.Err, Status(result))
+		}
+		select {
+		case <-s.stopped:
+		case <-time.After(time.Second):
+			t.Errorf("solver was not cancelled")
+		}
+	})
+	t.Run("Stubborn", func(t *testing.T) {
+		start := time.Now()
+		result := RunPart(context.Background(), stubbornSolver{}, 1, 1, "", Options{Timeout: 10 * time.Millisecond})
+		if Status(result) != StatusTimeout {
+			t.Errorf("RunPart() error = %v, expected timeout", result.Err)
+		}
+		if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+			t.Errorf("RunPart() took %v, expected to stop waiting at the timeout", elapsed)
+		}
+	})
+	t.Run("In time", func(t *testing.T) {
+		result := RunPart(context.Background(), stubbornSolver{}, 1, 2, "", Options{Timeout: time.Second})
+		if result.Err != nil || !result.Answer.Equal(solver.Int(2)) {
+			t.Errorf("RunPart() = %v, %v, expected 2", result.Answer, result.Err)
+		}
+	})
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"aoc2025/aoc"
+	"aoc2025/registry"
+	"aoc2025/runner"
+	"aoc2025/solver"
+)
+
+// DefaultMaxInput is the largest request body accepted as puzzle input
+const DefaultMaxInput = 1 << 20
+
+// statusClientClosedRequest answers a part cancelled because the client went
+// away, nobody reads it but it keeps such parts apart from bad input in logs
+const statusClientClosedRequest = 499
+
+// Options configure the server
+type Options struct {
+	Timeout  time.Duration    // time limit for a part, 0 for none, a timeout query parameter can only shorten it
+	MaxInput int64            // largest input accepted, DefaultMaxInput when zero
+	Now      func() time.Time // clock used for unlock state, time.Now when nil
+	Year     int              // year run by POST /days/{n}/parts/{p}, the latest registered year when zero
+}
+
+// Server answers requests to list and run the registered days
+type Server struct {
+	entries []registry.Entry
+	opts    Options
+	mux     *http.ServeMux
+}
+
+// DayInfo describes a registered day in GET /days
+type DayInfo struct {
+	Year      int       `json:"year"`
+	Day       int       `json:"day"`
+	Title     string    `json:"title"`
+	Unlocked  bool      `json:"unlocked"`
+	UnlocksAt time.Time `json:"unlocks_at"`
+}
+
+// PartResult is the reply to POST /years/{y}/days/{n}/parts/{p}
+type PartResult struct {
+	Year       int           `json:"year"`
+	Day        int           `json:"day"`
+	Part       int           `json:"part"`
+	Answer     solver.Answer `json:"answer"` // null when the part failed
+	Status     string        `json:"status"`
+	Error      string        `json:"error,omitempty"`
+	DurationNs int64         `json:"duration_ns"`
+	AllocBytes uint64        `json:"alloc_bytes"`
+	Allocs     uint64        `json:"allocs"`
+}
+
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+// New makes a server for the given days
+func New(entries []registry.Entry, opts Options) *Server {
+	if opts.MaxInput <= 0 {
+		opts.MaxInput = DefaultMaxInput
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	if opts.Year == 0 {
+		for _, entry := range entries {
+			opts.Year = max(opts.Year, entry.Year)
+		}
+	}
+	s := &Server{entries: entries, opts: opts, mux: http.NewServeMux()}
+	s.mux.HandleFunc("GET /days", s.listDays)
+	s.mux.HandleFunc("POST /days/{n}/parts/{p}", s.runPart)
+	s.mux.HandleFunc("POST /years/{y}/days/{n}/parts/{p}", s.runPart)
+	return s
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) listDays(w http.ResponseWriter, r *http.Request) {
+	now := s.opts.Now()
+	days := make([]DayInfo, 0, len(s.entries))
+	for _, entry := range s.entries {
+		unlocksAt := aoc.UnlockTime(entry.Year, entry.Day)
+		days = append(days, DayInfo{
+			Year:      entry.Year,
+			Day:       entry.Day,
+			Title:     entry.Title,
+			Unlocked:  !now.Before(unlocksAt),
+			UnlocksAt: unlocksAt,
+		})
+	}
+	writeJSON(w, http.StatusOK, days)
+}
+
+func (s *Server) runPart(w http.ResponseWriter, r *http.Request) {
+	year := s.opts.Year
+	if value := r.PathValue("y"); value != "" {
+		var err error
+		if year, err = strconv.Atoi(value); err != nil {
+			writeJSON(w, http.StatusBadRequest, errorReply{fmt.Sprintf("invalid year %q", value)})
+			return
+		}
+	}
+	day, err := strconv.Atoi(r.PathValue("n"))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, errorReply{fmt.Sprintf("invalid day %q", r.PathValue("n"))})
+		return
+	}
+	part, err := strconv.Atoi(r.PathValue("p"))
+	if err != nil || (part != 1 && part != 2) {
+		writeJSON(w, http.StatusBadRequest, errorReply{fmt.Sprintf("invalid part %q, expected 1 or 2", r.PathValue("p"))})
+		return
+	}
+	entry, ok := s.lookup(year, day)
+	if !ok {
+		writeJSON(w, http.StatusNotFound, errorReply{fmt.Sprintf("%d day %d is not registered", year, day)})
+		return
+	}
+	timeout := s.opts.Timeout
+	if value := r.URL.Query().Get("timeout"); value != "" {
+		timeout, err = time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			writeJSON(w, http.StatusBadRequest, errorReply{fmt.Sprintf("invalid timeout %q", value)})
+			return
+		}
+		if s.opts.Timeout > 0 {
+			timeout = min(timeout, s.opts.Timeout)
+		}
+	}
+	input, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.opts.MaxInput))
+	if err != nil {
+		status := http.StatusBadRequest
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		writeJSON(w, status, errorReply{err.Error()})
+		return
+	}
+
+	// the request context ends when the client goes away, which cancels the solver too
+	result := runner.RunPart(r.Context(), entry.Solver, day, part, string(input), runner.Options{Repeat: 1, Timeout: timeout})
+	reply := PartResult{
+		Year:       year,
+		Day:        day,
+		Part:       part,
+		Status:     runner.Status(result),
+		DurationNs: int64(result.Duration),
+		AllocBytes: result.Stats.AllocBytes,
+		Allocs:     result.Stats.Allocs,
+	}
+	if result.Err == nil {
+		reply.Answer = result.Answer
+	} else {
+		reply.Error = result.Err.Error()
+	}
+	writeJSON(w, statusCode(result.Err), reply)
+}
+
+func (s *Server) lookup(year, day int) (registry.Entry, bool) {
+	for _, entry := range s.entries {
+		if entry.Year == year && entry.Day == day {
+			return entry, true
+		}
+	}
+	return registry.Entry{}, false
+}
+
+// statusCode maps a part's error onto an HTTP status
+func statusCode(err error) int {
+	var panicErr *runner.PanicError
+	switch {
+	case err == nil:
+		return http.StatusOK
+	case errors.Is(err, solver.ErrNotUnlocked):
+		return http.StatusNotImplemented
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, context.Canceled):
+		return statusClientClosedRequest
+	case errors.As(err, &panicErr):
+		return http.StatusInternalServerError
+	default:
+		return http.StatusUnprocessableEntity
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
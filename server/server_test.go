@@ -0,0 +1,229 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"aoc2025/registry"
+	"aoc2025/solver"
+)
+
+// lineSolver counts lines in part 1 and has no part 2 yet
+type lineSolver struct{}
+
+func (lineSolver) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	if input == "" {
+		return solver.Answer{}, errors.New("empty input")
+	}
+	return solver.Int(len(strings.Split(input, "\n"))), nil
+}
+
+func (lineSolver) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	return solver.Answer{}, solver.ErrNotUnlocked
+}
+
+// waitingSolver runs until its context is cancelled
+type waitingSolver struct {
+	stopped chan struct{}
+}
+
+func (s waitingSolver) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	<-ctx.Done()
+	close(s.stopped)
+	return solver.Answer{}, ctx.Err()
+}
+
+func (s waitingSolver) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	panic("boom")
+}
+
+func newTestServer(opts Options) (*httptest.Server, waitingSolver) {
+	waiting := waitingSolver{stopped: make(chan struct{})}
+	entries := []registry.Entry{
+		{Year: 2025, Day: 1, Title: "Lines", Solver: lineSolver{}},
+		{Year: 2025, Day: 2, Title: "Waiting", Solver: waiting},
+	}
+	opts.Now = func() time.Time { return time.Date(2025, time.December, 1, 12, 0, 0, 0, time.UTC) }
+	return httptest.NewServer(New(entries, opts)), waiting
+}
+
+func TestListDays(t *testing.T) {
+	server, _ := newTestServer(Options{})
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/days")
+	if err != nil {
+		t.Fatalf("GET /days unexpected error %v", err)
+	}
+	defer resp.Body.Close()
+	days := []DayInfo{}
+	if err := json.NewDecoder(resp.Body).Decode(&days); err != nil {
+		t.Fatalf("GET /days reply is not JSON: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || len(days) != 2 {
+		t.Fatalf("GET /days = %d, %v, expected 200 and 2 days", resp.StatusCode, days)
+	}
+	if !days[0].Unlocked || days[1].Unlocked {
+		t.Errorf("GET /days unlocked = %v, %v, expected true, false", days[0].Unlocked, days[1].Unlocked)
+	}
+}
+
+func TestRunPart(t *testing.T) {
+	server, _ := newTestServer(Options{Timeout: time.Second})
+	defer server.Close()
+
+	tests := []struct {
+		path     string
+		input    string
+		code     int
+		status   string
+		expected solver.Answer
+	}{
+		{"/days/1/parts/1", "a\nb\nc", http.StatusOK, "ok", solver.Int(3)},
+		{"/days/1/parts/1", "", http.StatusUnprocessableEntity, "error", solver.Answer{}},
+		{"/days/1/parts/2", "a", http.StatusNotImplemented, "not unlocked", solver.Answer{}},
+		{"/days/2/parts/2", "a", http.StatusInternalServerError, "error", solver.Answer{}},
+		{"/days/3/parts/1", "a", http.StatusNotFound, "", solver.Answer{}},
+		{"/days/1/parts/3", "a", http.StatusBadRequest, "", solver.Answer{}},
+		{"/days/x/parts/1", "a", http.StatusBadRequest, "", solver.Answer{}},
+	}
+	for _, test := range tests {
+		resp, err := http.Post(server.URL+test.path, "text/plain", strings.NewReader(test.input))
+		if err != nil {
+			t.Fatalf("POST %s unexpected error %v", test.path, err)
+		}
+		var reply PartResult
+		json.NewDecoder(resp.Body).Decode(&reply)
+		resp.Body.Close()
+		if resp.StatusCode != test.code || reply.Status != test.status {
+			t.Errorf("POST %s = %d %q, expected %d %q", test.path, resp.StatusCode, reply.Status, test.code, test.status)
+		}
+		if !reply.Answer.Equal(test.expected) {
+			t.Errorf("POST %s answer = %v, expected %v", test.path, reply.Answer, test.expected)
+		}
+	}
+}
+
+func TestRunPartTimeout(t *testing.T) {
+	server, waiting := newTestServer(Options{Timeout: time.Minute})
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/days/2/parts/1?timeout=20ms", "text/plain", strings.NewReader("x"))
+	if err != nil {
+		t.Fatalf("POST unexpected error %v", err)
+	}
+	var reply PartResult
+	json.NewDecoder(resp.Body).Decode(&reply)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusGatewayTimeout || reply.Status != "timeout" {
+		t.Errorf("POST with a timeout = %d %q, expected 504 timeout", resp.StatusCode, reply.Status)
+	}
+	select {
+	case <-waiting.stopped:
+	case <-time.After(time.Second):
+		t.Errorf("solver was not cancelled by the request timeout")
+	}
+}
+
+// yearSolver answers part 1 with its year
+type yearSolver int
+
+func (s yearSolver) Part1(ctx context.Context, input string) (solver.Answer, error) {
+	return solver.Int(int(s)), nil
+}
+
+func (s yearSolver) Part2(ctx context.Context, input string) (solver.Answer, error) {
+	return solver.Answer{}, solver.ErrNotUnlocked
+}
+
+func TestRunPartByYear(t *testing.T) {
+	entries := []registry.Entry{
+		{Year: 2024, Day: 1, Title: "Old", Solver: yearSolver(2024)},
+		{Year: 2025, Day: 1, Title: "New", Solver: yearSolver(2025)},
+	}
+	server := httptest.NewServer(New(entries, Options{}))
+	defer server.Close()
+
+	tests := []struct {
+		path     string
+		code     int
+		expected solver.Answer
+	}{
+		{"/years/2024/days/1/parts/1", http.StatusOK, solver.Int(2024)},
+		{"/years/2025/days/1/parts/1", http.StatusOK, solver.Int(2025)},
+		{"/days/1/parts/1", http.StatusOK, solver.Int(2025)},
+		{"/years/2023/days/1/parts/1", http.StatusNotFound, solver.Answer{}},
+		{"/years/x/days/1/parts/1", http.StatusBadRequest, solver.Answer{}},
+	}
+	for _, test := range tests {
+		resp, err := http.Post(server.URL+test.path, "text/plain", strings.NewReader("a"))
+		if err != nil {
+			t.Fatalf("POST %s unexpected error %v", test.path, err)
+		}
+		var reply PartResult
+		json.NewDecoder(resp.Body).Decode(&reply)
+		resp.Body.Close()
+		if resp.StatusCode != test.code || !reply.Answer.Equal(test.expected) {
+			t.Errorf("POST %s = %d %v, expected %d %v", test.path, resp.StatusCode, reply.Answer, test.code, test.expected)
+		}
+	}
+}
+
+// brokenBody fails every read
+type brokenBody struct{}
+
+func (brokenBody) Read(p []byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestRunPartBody(t *testing.T) {
+	handler := New([]registry.Entry{{Year: 2025, Day: 1, Title: "Lines", Solver: lineSolver{}}}, Options{MaxInput: 4})
+	tests := []struct {
+		body io.Reader
+		code int
+	}{
+		{strings.NewReader("a\nb"), http.StatusOK},
+		{strings.NewReader("a\nb\nc"), http.StatusRequestEntityTooLarge},
+		{brokenBody{}, http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/days/1/parts/1", test.body))
+		if recorder.Code != test.code {
+			t.Errorf("POST with body %T = %d, expected %d", test.body, recorder.Code, test.code)
+		}
+	}
+}
+
+func TestRunPartTimeoutIsCapped(t *testing.T) {
+	server, _ := newTestServer(Options{Timeout: 20 * time.Millisecond})
+	defer server.Close()
+
+	start := time.Now()
+	resp, err := http.Post(server.URL+"/days/2/parts/1?timeout=1h", "text/plain", strings.NewReader("x"))
+	if err != nil {
+		t.Fatalf("POST unexpected error %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusGatewayTimeout || time.Since(start) > 5*time.Second {
+		t.Errorf("POST with a timeout above the limit = %d after %v, expected 504 at the server's limit", resp.StatusCode, time.Since(start))
+	}
+}
+
+func TestRunPartClientGone(t *testing.T) {
+	handler := New([]registry.Entry{{Year: 2025, Day: 2, Title: "Waiting", Solver: waitingSolver{stopped: make(chan struct{})}}}, Options{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/days/2/parts/1", strings.NewReader("x")).WithContext(ctx))
+	if recorder.Code != statusClientClosedRequest {
+		t.Errorf("POST from a client that went away = %d, expected %d", recorder.Code, statusClientClosedRequest)
+	}
+}
@@ -0,0 +1,157 @@
+package solver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+type answerKind uint8
+
+const (
+	noAnswer answerKind = iota
+	intAnswer
+	bigAnswer
+	textAnswer
+)
+
+// Answer is the value a part returns: an integer, a big integer or a string
+// integers are kept canonical, so a big integer that fits an int64 is stored as one
+// and answers compare and format the same however they were made
+type Answer struct {
+	kind answerKind
+	n    int64
+	big  *big.Int
+	text string
+}
+
+// Int makes an answer from an int
+func Int(n int) Answer {
+	return Answer{kind: intAnswer, n: int64(n)}
+}
+
+// Int64 makes an answer from an int64
+func Int64(n int64) Answer {
+	return Answer{kind: intAnswer, n: n}
+}
+
+// Big makes an answer from a big integer, which is copied
+func Big(n *big.Int) Answer {
+	if n.IsInt64() {
+		return Int64(n.Int64())
+	}
+	return Answer{kind: bigAnswer, big: new(big.Int).Set(n)}
+}
+
+// Text makes an answer from a string, for puzzles whose answer is not a number
+func Text(s string) Answer {
+	return Answer{kind: textAnswer, text: s}
+}
+
+// Parse reads an answer back from its String form
+// decimal integers become numeric answers, anything else a text answer
+func Parse(s string) Answer {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return Int64(n)
+	}
+	if n, ok := new(big.Int).SetString(s, 10); ok {
+		return Big(n)
+	}
+	return Text(s)
+}
+
+// IsZero reports whether the answer holds no value
+func (a Answer) IsZero() bool {
+	return a.kind == noAnswer
+}
+
+// IsNumber reports whether the answer is an integer of any size
+func (a Answer) IsNumber() bool {
+	return a.kind == intAnswer || a.kind == bigAnswer
+}
+
+// Int64 returns the answer as an int64, if it is an integer that fits
+func (a Answer) Int64() (int64, bool) {
+	return a.n, a.kind == intAnswer
+}
+
+// BigInt returns the answer as a new big integer, if it is an integer
+func (a Answer) BigInt() (*big.Int, bool) {
+	switch a.kind {
+	case intAnswer:
+		return big.NewInt(a.n), true
+	case bigAnswer:
+		return new(big.Int).Set(a.big), true
+	default:
+		return nil, false
+	}
+}
+
+// String formats the answer canonically, the way it is submitted
+// integers are plain decimal, text is as is, and no answer is empty
+func (a Answer) String() string {
+	switch a.kind {
+	case intAnswer:
+		return strconv.FormatInt(a.n, 10)
+	case bigAnswer:
+		return a.big.String()
+	case textAnswer:
+		return a.text
+	default:
+		return ""
+	}
+}
+
+// Equal reports whether two answers hold the same value
+// a number never equals a text answer, even one with the same digits
+func (a Answer) Equal(b Answer) bool {
+	if a.kind != b.kind {
+		return false
+	}
+	switch a.kind {
+	case intAnswer:
+		return a.n == b.n
+	case bigAnswer:
+		return a.big.Cmp(b.big) == 0
+	case textAnswer:
+		return a.text == b.text
+	default:
+		return true
+	}
+}
+
+// MarshalJSON writes numbers as JSON numbers, text as a JSON string and no answer as null
+func (a Answer) MarshalJSON() ([]byte, error) {
+	switch a.kind {
+	case intAnswer, bigAnswer:
+		return []byte(a.String()), nil
+	case textAnswer:
+		return json.Marshal(a.text)
+	default:
+		return []byte("null"), nil
+	}
+}
+
+// UnmarshalJSON reads what MarshalJSON writes, keeping big numbers exact
+func (a *Answer) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*a = Answer{}
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*a = Text(s)
+	default:
+		n, ok := new(big.Int).SetString(string(data), 10)
+		if !ok {
+			return fmt.Errorf("answer %s is not an integer or a string", data)
+		}
+		*a = Big(n)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package solver
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestAnswerString(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	tests := []struct {
+		answer   Answer
+		expected string
+	}{
+		{Int(42), "42"},
+		{Int64(-7), "-7"},
+		{Big(big.NewInt(3121910778619)), "3121910778619"},
+		{Big(huge), "123456789012345678901234567890"},
+		{Text("EOJDLZNF"), "EOJDLZNF"},
+		{Answer{}, ""},
+	}
+	for _, test := range tests {
+		if result := test.answer.String(); result != test.expected {
+			t.Errorf("String() = %q, expected %q", result, test.expected)
+		}
+	}
+}
+
+func TestAnswerEqual(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	tests := []struct {
+		a, b     Answer
+		expected bool
+	}{
+		{Int(5), Int64(5), true},
+		{Int(5), Big(big.NewInt(5)), true},
+		{Big(huge), Parse(huge.String()), true},
+		{Big(huge), Int(5), false},
+		{Int(5), Text("5"), false},
+		{Text("a"), Text("a"), true},
+		{Answer{}, Answer{}, true},
+		{Answer{}, Int(0), false},
+	}
+	for _, test := range tests {
+		if result := test.a.Equal(test.b); result != test.expected {
+			t.Errorf("%v.Equal(%v) = %v, expected %v", test.a, test.b, result, test.expected)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Answer
+		number   bool
+	}{
+		{"357", Int(357), true},
+		{"-3", Int(-3), true},
+		{"123456789012345678901234567890", Parse("123456789012345678901234567890"), true},
+		{"1,2,3", Text("1,2,3"), false},
+		{"", Text(""), false},
+	}
+	for _, test := range tests {
+		result := Parse(test.input)
+		if !result.Equal(test.expected) || result.IsNumber() != test.number || result.String() != test.input {
+			t.Errorf("Parse(%q) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestAnswerJSON(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	tests := []struct {
+		answer   Answer
+		expected string
+	}{
+		{Int(42), `42`},
+		{Big(huge), `123456789012345678901234567890`},
+		{Text(`say "hi"`), `"say \"hi\""`},
+		{Answer{}, `null`},
+	}
+	for _, test := range tests {
+		data, err := json.Marshal(test.answer)
+		if err != nil || string(data) != test.expected {
+			t.Errorf("json.Marshal(%v) = %s, %v, expected %s", test.answer, data, err, test.expected)
+		}
+		var decoded Answer
+		if err := json.Unmarshal(data, &decoded); err != nil || !decoded.Equal(test.answer) {
+			t.Errorf("json.Unmarshal(%s) = %v, %v, expected %v", data, decoded, err, test.answer)
+		}
+	}
+	var decoded Answer
+	if err := json.Unmarshal([]byte(`1.5`), &decoded); err == nil {
+		t.Errorf("json.Unmarshal(1.5) expected an error")
+	}
+}
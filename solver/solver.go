@@ -0,0 +1,104 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// ErrNotUnlocked is returned by a part that has not been unlocked yet
+var ErrNotUnlocked = errors.New("not yet unlocked")
+
+// Solver represents a single day's solution for Advent of Code.
+// It replaces the Day interface: both parts take a context and report
+// failures as errors instead of sentinel values or panics.
+type Solver interface {
+	// Part1 solves part 1 of the day's puzzle
+	Part1(ctx context.Context, input string) (Answer, error)
+	// Part2 solves part 2 of the day's puzzle
+	// Returns ErrNotUnlocked if the part is not yet unlocked
+	Part2(ctx context.Context, input string) (Answer, error)
+}
+
+// Streamer is implemented by days that can read their input incrementally,
+// record by record, instead of holding all of it in a string
+// the runner prefers it over Solver when a day implements both
+type Streamer interface {
+	// Part1Reader solves part 1 reading the input from r
+	Part1Reader(ctx context.Context, r io.Reader) (Answer, error)
+	// Part2Reader solves part 2 reading the input from r
+	Part2Reader(ctx context.Context, r io.Reader) (Answer, error)
+}
+
+// Legacy is the original Day contract, kept so old implementations can
+// still be run through the Solver interface
+type Legacy interface {
+	Part1(input string) interface{}
+	Part2(input string) (interface{}, bool)
+}
+
+// FromLegacy adapts a Legacy day to the Solver interface
+func FromLegacy(day Legacy) Solver {
+	return legacyAdapter{day: day}
+}
+
+type legacyAdapter struct {
+	day Legacy
+}
+
+func (a legacyAdapter) Part1(ctx context.Context, input string) (Answer, error) {
+	if err := ctx.Err(); err != nil {
+		return Answer{}, err
+	}
+	return toAnswer(a.day.Part1(input))
+}
+
+func (a legacyAdapter) Part2(ctx context.Context, input string) (Answer, error) {
+	if err := ctx.Err(); err != nil {
+		return Answer{}, err
+	}
+	answer, unlocked := a.day.Part2(input)
+	if !unlocked {
+		return Answer{}, ErrNotUnlocked
+	}
+	return toAnswer(answer)
+}
+
+// legacy days return interface{}, so accept any integer type, big integers and strings
+func toAnswer(answer interface{}) (Answer, error) {
+	switch v := answer.(type) {
+	case Answer:
+		return v, nil
+	case int:
+		return Int(v), nil
+	case int8:
+		return Int64(int64(v)), nil
+	case int16:
+		return Int64(int64(v)), nil
+	case int32:
+		return Int64(int64(v)), nil
+	case int64:
+		return Int64(v), nil
+	case uint:
+		return Big(new(big.Int).SetUint64(uint64(v))), nil
+	case uint8:
+		return Int64(int64(v)), nil
+	case uint16:
+		return Int64(int64(v)), nil
+	case uint32:
+		return Int64(int64(v)), nil
+	case uint64:
+		return Big(new(big.Int).SetUint64(v)), nil
+	case *big.Int:
+		if v == nil {
+			return Answer{}, fmt.Errorf("nil big.Int answer")
+		}
+		return Big(v), nil
+	case string:
+		return Text(v), nil
+	default:
+		return Answer{}, fmt.Errorf("unsupported answer type %T", answer)
+	}
+}
@@ -0,0 +1,65 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeLegacy struct {
+	part1    interface{}
+	part2    interface{}
+	unlocked bool
+}
+
+func (f fakeLegacy) Part1(input string) interface{} {
+	return f.part1
+}
+
+func (f fakeLegacy) Part2(input string) (interface{}, bool) {
+	return f.part2, f.unlocked
+}
+
+func TestFromLegacy(t *testing.T) {
+	tests := []struct {
+		name      string
+		day       fakeLegacy
+		expected1 Answer
+		expected2 Answer
+		err1      bool
+		err2      error
+	}{
+		{"ints", fakeLegacy{part1: 3, part2: int64(6), unlocked: true}, Int(3), Int(6), false, nil},
+		{"locked", fakeLegacy{part1: 3, unlocked: false}, Int(3), Answer{}, false, ErrNotUnlocked},
+		{"string answer", fakeLegacy{part1: "abc", part2: uint64(1 << 63), unlocked: true}, Text("abc"), Parse("9223372036854775808"), false, nil},
+		{"unsupported answer", fakeLegacy{part1: 1.5, part2: 1, unlocked: true}, Answer{}, Int(1), true, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := FromLegacy(test.day)
+			result, err := s.Part1(context.Background(), "")
+			if (err != nil) != test.err1 {
+				t.Errorf("Part1() error = %v, expected error %t", err, test.err1)
+			}
+			if !result.Equal(test.expected1) {
+				t.Errorf("Part1() = %v, expected %v", result, test.expected1)
+			}
+			result, err = s.Part2(context.Background(), "")
+			if !errors.Is(err, test.err2) {
+				t.Errorf("Part2() error = %v, expected %v", err, test.err2)
+			}
+			if !result.Equal(test.expected2) {
+				t.Errorf("Part2() = %v, expected %v", result, test.expected2)
+			}
+		})
+	}
+}
+
+func TestFromLegacyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := FromLegacy(fakeLegacy{part1: 1}).Part1(ctx, "")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Part1() error = %v, expected %v", err, context.Canceled)
+	}
+}
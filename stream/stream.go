@@ -0,0 +1,36 @@
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// MaxRecord is the longest line or record a scanner accepts
+const MaxRecord = 64 << 20
+
+// Lines scans r line by line, without the line endings
+func Lines(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxRecord)
+	return scanner
+}
+
+// Separated scans r as records separated by sep, such as the comma separated ranges of day 2
+// the separator is not included, a trailing newline is left on the last record
+func Separated(r io.Reader, sep byte) *bufio.Scanner {
+	scanner := Lines(r)
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.IndexByte(data, sep); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+		if atEOF {
+			return 0, nil, io.EOF
+		}
+		return 0, nil, nil
+	})
+	return scanner
+}
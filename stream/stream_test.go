@@ -0,0 +1,59 @@
+package stream
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func collect(scanner *bufio.Scanner) ([]string, error) {
+	records := []string{}
+	for scanner.Scan() {
+		records = append(records, scanner.Text())
+	}
+	return records, scanner.Err()
+}
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"L68\nL30\nR48", []string{"L68", "L30", "R48"}},
+		{"L68\r\nL30\n", []string{"L68", "L30"}},
+		{"", []string{}},
+	}
+	for _, test := range tests {
+		result, err := collect(Lines(iotest.OneByteReader(strings.NewReader(test.input))))
+		if err != nil || !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Lines(%q) = %q, %v, expected %q", test.input, result, err, test.expected)
+		}
+	}
+}
+
+func TestSeparated(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"11-22,95-115,998-1012\n", []string{"11-22", "95-115", "998-1012\n"}},
+		{"11-22,", []string{"11-22"}},
+		{"", []string{}},
+	}
+	for _, test := range tests {
+		result, err := collect(Separated(iotest.OneByteReader(strings.NewReader(test.input)), ','))
+		if err != nil || !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Separated(%q) = %q, %v, expected %q", test.input, result, err, test.expected)
+		}
+	}
+}
+
+func TestLongLines(t *testing.T) {
+	line := strings.Repeat("9", 1<<20)
+	result, err := collect(Lines(strings.NewReader(line + "\n1")))
+	if err != nil || len(result) != 2 || result[0] != line {
+		t.Errorf("Lines() of a 1 MiB line = %d lines, %v, expected the line and 1", len(result), err)
+	}
+}
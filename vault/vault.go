@@ -0,0 +1,161 @@
+package vault
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	// Ext is added to an input path for its encrypted copy, dayN/data.txt.enc
+	Ext = ".enc"
+	// KeyEnv is the environment variable holding the key, hex encoded
+	KeyEnv = "AOC_INPUT_KEY"
+	// KeyFileEnv is the environment variable naming a key file, DefaultKeyFile when unset
+	KeyFileEnv = "AOC_INPUT_KEY_FILE"
+	// DefaultKeyFile is the key file used when neither variable is set
+	DefaultKeyFile = ".aoc-key"
+	// KeySize is the length of an AES-256 key in bytes
+	KeySize = 32
+)
+
+// magic starts every encrypted file, so the format can change later
+var magic = []byte("AOC1")
+
+var (
+	// ErrNoKey is returned when no key is set in the environment or key file
+	ErrNoKey = errors.New("no input key, set " + KeyEnv + " or write one to " + DefaultKeyFile)
+	// ErrDecrypt is returned when a file was not encrypted with the key, or was changed
+	ErrDecrypt = errors.New("cannot decrypt, wrong key or corrupted file")
+)
+
+// ParseKey decodes a hex encoded key
+func ParseKey(s string) ([]byte, error) {
+	key, err := hex.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("key is not hex: %w", err)
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("key is %d bytes, expected %d", len(key), KeySize)
+	}
+	return key, nil
+}
+
+// KeyFile is the key file in use, from KeyFileEnv or DefaultKeyFile
+func KeyFile() string {
+	if path := os.Getenv(KeyFileEnv); path != "" {
+		return path
+	}
+	return DefaultKeyFile
+}
+
+// LoadKey reads the key from KeyEnv, or from the key file
+func LoadKey() ([]byte, error) {
+	if s := os.Getenv(KeyEnv); s != "" {
+		return ParseKey(s)
+	}
+	return ReadKeyFile(KeyFile())
+}
+
+// ReadKeyFile reads a hex encoded key from path
+func ReadKeyFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNoKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	key, err := ParseKey(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return key, nil
+}
+
+// GenerateKey makes a new random key
+func GenerateKey() []byte {
+	key := make([]byte, KeySize)
+	rand.Read(key)
+	return key
+}
+
+// WriteKeyFile writes a key to path, readable by the owner only
+// an existing file is never overwritten
+func WriteKeyFile(path string, key []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(f, hex.EncodeToString(key)); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Encrypt seals plaintext with AES-GCM under a random nonce
+// the result is the magic header, the nonce and the ciphertext
+func Encrypt(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	rand.Read(nonce)
+	out := append(append([]byte{}, magic...), nonce...)
+	return gcm.Seal(out, nonce, plaintext, magic), nil
+}
+
+// Decrypt opens data made by Encrypt
+func Decrypt(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(data, magic) || len(data) < len(magic)+gcm.NonceSize() {
+		return nil, fmt.Errorf("not an encrypted input: %w", ErrDecrypt)
+	}
+	data = data[len(magic):]
+	plaintext, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], magic)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+// ReadFile decrypts the file at path
+func ReadFile(key []byte, path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := Decrypt(key, data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return plaintext, nil
+}
+
+// WriteFile encrypts plaintext to path
+func WriteFile(key []byte, path string, plaintext []byte) error {
+	data, err := Encrypt(key, plaintext)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,80 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := GenerateKey()
+	tests := []string{"", "L68\nL30\nR48", strings.Repeat("987654321111111\n", 100)}
+	for _, plaintext := range tests {
+		data, err := Encrypt(key, []byte(plaintext))
+		if err != nil {
+			t.Fatalf("Encrypt(%q) unexpected error %v", plaintext, err)
+		}
+		if len(plaintext) > 0 && bytes.Contains(data, []byte(plaintext)) {
+			t.Errorf("Encrypt(%q) contains the plaintext", plaintext)
+		}
+		result, err := Decrypt(key, data)
+		if err != nil || string(result) != plaintext {
+			t.Errorf("Decrypt(Encrypt(%q)) = %q, %v, expected the plaintext", plaintext, result, err)
+		}
+	}
+}
+
+func TestDecryptErrors(t *testing.T) {
+	key := GenerateKey()
+	data, _ := Encrypt(key, []byte("secret input"))
+	tampered := append([]byte{}, data...)
+	tampered[len(tampered)-1] ^= 1
+
+	tests := []struct {
+		name string
+		key  []byte
+		data []byte
+	}{
+		{"wrong key", GenerateKey(), data},
+		{"tampered", key, tampered},
+		{"plain text", key, []byte("secret input")},
+		{"truncated", key, data[:6]},
+	}
+	for _, test := range tests {
+		if _, err := Decrypt(test.key, test.data); !errors.Is(err, ErrDecrypt) {
+			t.Errorf("Decrypt(%s) error = %v, expected ErrDecrypt", test.name, err)
+		}
+	}
+}
+
+func TestLoadKey(t *testing.T) {
+	key := GenerateKey()
+	dir := t.TempDir()
+	t.Setenv(KeyEnv, hex.EncodeToString(key))
+	if result, err := LoadKey(); err != nil || !bytes.Equal(result, key) {
+		t.Errorf("LoadKey() from %s = %x, %v, expected %x", KeyEnv, result, err, key)
+	}
+
+	t.Setenv(KeyEnv, "")
+	t.Setenv(KeyFileEnv, filepath.Join(dir, "key"))
+	if _, err := LoadKey(); !errors.Is(err, ErrNoKey) {
+		t.Errorf("LoadKey() without a key error = %v, expected ErrNoKey", err)
+	}
+	if err := WriteKeyFile(KeyFile(), key); err != nil {
+		t.Fatalf("WriteKeyFile() unexpected error %v", err)
+	}
+	if result, err := LoadKey(); err != nil || !bytes.Equal(result, key) {
+		t.Errorf("LoadKey() from key file = %x, %v, expected %x", result, err, key)
+	}
+	if err := WriteKeyFile(KeyFile(), GenerateKey()); err == nil {
+		t.Errorf("WriteKeyFile() over an existing key expected an error")
+	}
+
+	t.Setenv(KeyEnv, "abcd")
+	if _, err := LoadKey(); err == nil {
+		t.Errorf("LoadKey() with a short key expected an error")
+	}
+}
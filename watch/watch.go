@@ -0,0 +1,94 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// DefaultInterval is how often watched paths are polled
+const DefaultInterval = 500 * time.Millisecond
+
+type fileState struct {
+	size    int64
+	modTime time.Time
+}
+
+// Snapshot is the state of every file under a set of paths
+type Snapshot map[string]fileState
+
+// Take records the size and modification time of every file under paths
+// directories are walked, paths that do not exist are left out
+func Take(paths []string) (Snapshot, error) {
+	snapshot := Snapshot{}
+	for _, root := range paths {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			snapshot[path] = fileState{size: info.Size(), modTime: info.ModTime()}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
+// Changed lists the files added, removed or modified since old, sorted
+func (s Snapshot) Changed(old Snapshot) []string {
+	changed := []string{}
+	for path, state := range s {
+		if previous, ok := old[path]; !ok || previous != state {
+			changed = append(changed, path)
+		}
+	}
+	for path := range old {
+		if _, ok := s[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// Wait polls paths every interval until they differ from before, returning what changed
+// take before ahead of any work done between waits, so changes made during it are seen
+// a change is only reported once the files stay the same for a whole interval,
+// so a file that is still being written does not trigger a rerun
+// it returns the context's error if the context ends first
+func Wait(ctx context.Context, paths []string, before Snapshot, interval time.Duration) ([]string, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var previous Snapshot
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+		after, err := Take(paths)
+		if err != nil {
+			return nil, err
+		}
+		changed := after.Changed(before)
+		if len(changed) > 0 && previous != nil && len(after.Changed(previous)) == 0 {
+			return changed, nil
+		}
+		previous = after
+	}
+}
@@ -0,0 +1,71 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestChanged(t *testing.T) {
+	dir := t.TempDir()
+	day := filepath.Join(dir, "day3")
+	os.Mkdir(day, 0o755)
+	os.WriteFile(filepath.Join(day, "day3.go"), []byte("package day3\n"), 0o644)
+	os.WriteFile(filepath.Join(day, "day.go"), []byte("package day3\n"), 0o644)
+	input := filepath.Join(day, "data.txt")
+	paths := []string{day, filepath.Join(dir, "missing.txt")}
+
+	before, err := Take(paths)
+	if err != nil {
+		t.Fatalf("Take() unexpected error %v", err)
+	}
+	if changed := before.Changed(before); len(changed) != 0 {
+		t.Errorf("Changed() of the same snapshot = %v, expected none", changed)
+	}
+
+	os.WriteFile(filepath.Join(day, "day3.go"), []byte("package day3\n\nfunc f() {}\n"), 0o644)
+	os.WriteFile(input, []byte("1234\n"), 0o644)
+	os.Remove(filepath.Join(day, "day.go"))
+	after, _ := Take(paths)
+	expected := []string{filepath.Join(day, "data.txt"), filepath.Join(day, "day.go"), filepath.Join(day, "day3.go")}
+	if changed := after.Changed(before); !reflect.DeepEqual(changed, expected) {
+		t.Errorf("Changed() = %v, expected %v", changed, expected)
+	}
+}
+
+func TestWait(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.txt")
+	before, _ := Take([]string{dir})
+	written := make(chan struct{})
+	go func() {
+		defer close(written)
+		time.Sleep(30 * time.Millisecond)
+		os.WriteFile(path, []byte("new\n"), 0o644)
+	}()
+	changed, err := Wait(context.Background(), []string{dir}, before, 5*time.Millisecond)
+	if err != nil || !reflect.DeepEqual(changed, []string{path}) {
+		t.Errorf("Wait() = %v, %v, expected %v", changed, err, []string{path})
+	}
+	// the write may still be landing, the next snapshot must come after it
+	<-written
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	before, _ = Take([]string{dir})
+	if _, err := Wait(ctx, []string{dir}, before, 5*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait() without changes error = %v, expected context.DeadlineExceeded", err)
+	}
+
+	// a change made before Wait starts, such as during a rerun, is still seen
+	before, _ = Take([]string{dir})
+	os.WriteFile(path, []byte("newer\n"), 0o644)
+	changed, err = Wait(context.Background(), []string{dir}, before, 5*time.Millisecond)
+	if err != nil || !reflect.DeepEqual(changed, []string{path}) {
+		t.Errorf("Wait() after an early change = %v, %v, expected %v", changed, err, []string{path})
+	}
+}